@@ -0,0 +1,9 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "brickbybrick_exercise" "bench_press" {
+  name = "Barbell bench press"
+}
+
+data "brickbybrick_exercise" "by_id" {
+  id = 42
+}
@@ -0,0 +1,19 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "brickbybrick_exercise_progress" "back_squat" {
+  exercise_id = brickbybrick_exercise.back_squat.exercise_id
+}
+
+output "back_squat_gain" {
+  value = data.brickbybrick_exercise_progress.back_squat.current_weight - data.brickbybrick_exercise_progress.back_squat.starting_weight
+}
+
+check "back_squat_progressing" {
+  assert {
+    condition = length(data.brickbybrick_exercise_progress.back_squat.history) < 4 || (
+      data.brickbybrick_exercise_progress.back_squat.current_weight >
+      element(data.brickbybrick_exercise_progress.back_squat.history, length(data.brickbybrick_exercise_progress.back_squat.history) - 4).working_weight
+    )
+    error_message = "Back squat has not progressed in the last four sessions."
+  }
+}
@@ -1,3 +1,11 @@
 # Copyright (c) HashiCorp, Inc.
 
 data "brickbybrick_exercises" "my_exercises" {}
+
+data "brickbybrick_exercises" "heavy_barbell_lifts" {
+  name_prefix        = "Barbell"
+  min_default_weight = 95
+  muscle_groups      = ["quads", "glutes"]
+  sort_by            = "default_weight"
+  sort_order         = "desc"
+}
@@ -0,0 +1,19 @@
+# Copyright (c) HashiCorp, Inc.
+
+# The next workout of the account's active strategy.
+data "brickbybrick_next_workout" "today" {}
+
+data "brickbybrick_next_workout" "rapid_progress" {
+  strategy_id = brickbybrick_strategy.my_rapid_progress_strategy.strategy_id
+  weight_unit = "kg"
+}
+
+output "kiosk_plan" {
+  value = {
+    workout = data.brickbybrick_next_workout.today.workout_template_name
+    exercises = [
+      for exercise in data.brickbybrick_next_workout.today.exercises :
+      "${exercise.name}: ${exercise.sets} x ${exercise.reps} @ ${exercise.weight}"
+    ]
+  }
+}
@@ -0,0 +1,19 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "brickbybrick_personal_records" "all_time" {}
+
+data "brickbybrick_personal_records" "this_year" {
+  exercise_ids = [
+    brickbybrick_exercise.back_squat.exercise_id,
+    brickbybrick_exercise.bench_press.exercise_id,
+  ]
+  from        = "2026-01-01"
+  weight_unit = "kg"
+}
+
+output "estimated_one_rep_maxes" {
+  value = {
+    for record in data.brickbybrick_personal_records.this_year.records :
+    record.exercise_id => record.estimated_one_rep_max.estimated_one_rep_max
+  }
+}
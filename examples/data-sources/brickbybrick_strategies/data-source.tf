@@ -1,3 +1,9 @@
 # Copyright (c) HashiCorp, Inc.
 
 data "brickbybrick_strategies" "my_strategies" {}
+
+data "brickbybrick_strategies" "short_workouts" {
+  max_exercises_per_workout = 4
+  max_overload_rate         = 5
+  sort_by                   = "display_name"
+}
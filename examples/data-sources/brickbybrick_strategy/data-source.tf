@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "brickbybrick_strategy" "rapid_progress" {
+  display_name = "Rapid Progress"
+}
@@ -0,0 +1,17 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "brickbybrick_training_volume" "last_four_weeks" {
+  from = "2026-09-21"
+  to   = "2026-10-18"
+}
+
+check "no_muscle_group_overloaded" {
+  assert {
+    condition = alltrue(flatten([
+      for week in data.brickbybrick_training_volume.last_four_weeks.weeks : [
+        for muscle_group in week.muscle_groups : muscle_group.sets <= 20
+      ]
+    ]))
+    error_message = "A muscle group was trained for more than 20 sets in one week."
+  }
+}
@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+
+data "brickbybrick_workout_sessions" "recent" {
+  from = "2026-09-01"
+}
+
+data "brickbybrick_workout_sessions" "squat_history" {
+  exercise_ids = [brickbybrick_exercise.back_squat.exercise_id]
+  page         = 2
+  page_size    = 20
+  weight_unit  = "kg"
+}
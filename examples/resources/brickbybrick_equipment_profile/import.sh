@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+
+# An equipment profile can be imported by specifying the numeric identifier.
+terraform import brickbybrick_equipment_profile.example 123
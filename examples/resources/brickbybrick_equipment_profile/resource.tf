@@ -0,0 +1,40 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "brickbybrick_equipment_profile" "home_gym" {
+  name                 = "Home gym"
+  weight_unit          = "lb"
+  on_unloadable_weight = "snap"
+
+  barbells = [
+    {
+      name   = "Olympic bar"
+      weight = 45
+    },
+  ]
+
+  plates = [
+    { weight = 45, pairs = 2 },
+    { weight = 25, pairs = 1 },
+    { weight = 10, pairs = 2 },
+    { weight = 5, pairs = 1 },
+    { weight = 2.5, pairs = 1 },
+  ]
+
+  dumbbell_sets = [
+    {
+      name       = "Adjustable dumbbells"
+      min_weight = 5
+      max_weight = 52.5
+      increment  = 2.5
+    },
+  ]
+}
+
+# default_weight is snapped to 135 lb, the nearest weight the home gym can
+# load, and exposed as loadable_default_weight.
+resource "brickbybrick_exercise" "back_squat" {
+  name                 = "Back Squat"
+  equipment            = "barbell"
+  default_weight       = 137
+  equipment_profile_id = brickbybrick_equipment_profile.home_gym.equipment_profile_id
+}
@@ -2,3 +2,6 @@
 
 # An exercise can be imported by specifying the numeric identifier.
 terraform import brickbybrick_exercise.example 123
+
+# An exercise can also be imported by its exact name.
+terraform import brickbybrick_exercise.example "name:Dumbbell floor press"
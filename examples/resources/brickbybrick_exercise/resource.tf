@@ -1,6 +1,12 @@
 # Copyright (c) HashiCorp, Inc.
 
 resource "brickbybrick_exercise" "my_exercise" {
-  name           = "Dumbbell floor press"
-  default_weight = 10
+  name              = "Dumbbell floor press"
+  default_weight    = 10
+  primary_muscles   = ["chest"]
+  secondary_muscles = ["triceps", "front_delts"]
+  equipment         = "dumbbell"
+  category          = "compound"
+  movement_pattern  = "horizontal_push"
+  notes             = "Pause briefly with the elbows on the floor."
 }
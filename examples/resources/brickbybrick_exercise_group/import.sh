@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+
+# An exercise group can be imported by specifying the numeric identifier.
+terraform import brickbybrick_exercise_group.example 123
@@ -0,0 +1,15 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "brickbybrick_exercise_group" "bench_and_row" {
+  name                = "Bench and row superset"
+  workout_template_id = brickbybrick_workout_template.day_a.workout_template_id
+  type                = "superset"
+  rounds              = 3
+
+  exercise_ids = [
+    brickbybrick_exercise.bench_press.exercise_id,
+    brickbybrick_exercise.barbell_row.exercise_id,
+  ]
+
+  rest_between_rounds_seconds = 90
+}
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+
+# A goal can be imported by specifying the numeric identifier.
+terraform import brickbybrick_goal.example 123
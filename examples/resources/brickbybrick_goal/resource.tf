@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "brickbybrick_goal" "bench_225" {
+  exercise_id       = brickbybrick_exercise.bench_press.exercise_id
+  strategy_id       = brickbybrick_strategy.my_rapid_progress_strategy.strategy_id
+  target_weight     = 225
+  target_reps       = 1
+  target_date       = "2027-03-01"
+  sessions_per_week = 2
+}
+
+output "bench_225_status" {
+  value = brickbybrick_goal.bench_225.status
+}
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+
+# A program can be imported by specifying the numeric identifier.
+terraform import brickbybrick_program.example 123
@@ -0,0 +1,27 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "brickbybrick_program" "spring_meet_prep" {
+  name       = "Spring Meet Prep"
+  start_date = "2027-01-04"
+
+  phase {
+    name                 = "Hypertrophy"
+    duration_weeks       = 4
+    strategy_id          = brickbybrick_strategy.hypertrophy.strategy_id
+    workout_template_ids = [brickbybrick_workout_template.upper.workout_template_id, brickbybrick_workout_template.lower.workout_template_id]
+  }
+
+  phase {
+    name                 = "Strength"
+    duration_weeks       = 4
+    strategy_id          = brickbybrick_strategy.strength.strategy_id
+    workout_template_ids = [brickbybrick_workout_template.day_a.workout_template_id, brickbybrick_workout_template.day_b.workout_template_id]
+  }
+
+  phase {
+    name                 = "Peaking"
+    duration_weeks       = 2
+    strategy_id          = brickbybrick_strategy.peaking.strategy_id
+    workout_template_ids = [brickbybrick_workout_template.day_a.workout_template_id]
+  }
+}
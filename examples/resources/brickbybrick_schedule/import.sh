@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+
+# A schedule can be imported by specifying the numeric identifier.
+terraform import brickbybrick_schedule.example 123
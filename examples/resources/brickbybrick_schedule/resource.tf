@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+
+# Train on fixed weekdays.
+resource "brickbybrick_schedule" "weekly" {
+  name     = "Three Day Split"
+  timezone = "Europe/London"
+
+  weekdays = {
+    monday    = brickbybrick_workout_template.day_a.workout_template_id
+    wednesday = brickbybrick_workout_template.day_b.workout_template_id
+    friday    = brickbybrick_workout_template.day_c.workout_template_id
+  }
+
+  excluded_dates = ["2026-12-25", "2027-01-01"]
+}
+
+# Alternate two workouts every other day.
+resource "brickbybrick_schedule" "alternating" {
+  name       = "A/B Every Other Day"
+  timezone   = "America/New_York"
+  start_date = "2026-11-02"
+  recurrence = "FREQ=DAILY;INTERVAL=2"
+
+  rotation = [
+    brickbybrick_workout_template.day_a.workout_template_id,
+    brickbybrick_workout_template.day_b.workout_template_id,
+  ]
+
+  upcoming_count = 14
+}
@@ -2,3 +2,6 @@
 
 # A strategy can be imported by specifying the numeric identifier.
 terraform import brickbybrick_strategy.example 123
+
+# A strategy can also be imported by its exact display name.
+terraform import brickbybrick_strategy.example "display_name:5x5 Linear Progression"
@@ -0,0 +1,4 @@
+# Copyright (c) HashiCorp, Inc.
+
+# A workout template can be imported by specifying the numeric identifier.
+terraform import brickbybrick_workout_template.example 123
@@ -0,0 +1,24 @@
+# Copyright (c) HashiCorp, Inc.
+
+resource "brickbybrick_workout_template" "day_a" {
+  name        = "Day A"
+  strategy_id = brickbybrick_strategy.my_rapid_progress_strategy.strategy_id
+
+  exercises = [
+    {
+      exercise_id  = brickbybrick_exercise.back_squat.exercise_id
+      sets         = 5
+      rest_seconds = 180
+    },
+    {
+      exercise_id = brickbybrick_exercise.bench_press.exercise_id
+    },
+    {
+      exercise_id = brickbybrick_exercise.barbell_row.exercise_id
+      reps        = 8
+    },
+    {
+      exercise_id = brickbybrick_exercise.plank.exercise_id
+    },
+  ]
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resolveAdoptExisting returns whether Create should take over an existing
+// object with the same name, using the provider-wide adopt_existing when the
+// resource does not set it.
+func resolveAdoptExisting(override types.Bool, client *BrickByBrickClient) bool {
+	if !override.IsNull() && !override.IsUnknown() {
+		return override.ValueBool()
+	}
+	return client != nil && client.AdoptExisting
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -17,13 +19,31 @@ type BrickByBrickClient struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+	// WeightUnit is the unit, "lb" or "kg", that weights are expressed in
+	// within Terraform configuration. The API itself always uses lbs.
+	WeightUnit string
+	// WeightPrecision is the smallest difference, in WeightUnit, between two
+	// weights that counts as a change.
+	WeightPrecision float64
+	// DefaultDeletionProtection, when set, overrides the deletion_protection
+	// default of every resource.
+	DefaultDeletionProtection *bool
+	// AdoptExisting makes resources take over an existing object with the
+	// same name on create, unless the resource sets adopt_existing itself.
+	AdoptExisting bool
 }
 
+// defaultHostURL is the base URL of the BrickByBrick API.
+const defaultHostURL = "https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api"
+
 // NewClient -
 func NewClient(apiKey *string) (*BrickByBrickClient, error) {
 	c := BrickByBrickClient{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
-		Token:      *apiKey,
+		HostURL:         defaultHostURL,
+		HTTPClient:      &http.Client{Timeout: 10 * time.Second},
+		Token:           *apiKey,
+		WeightUnit:      weightUnitPounds,
+		WeightPrecision: defaultWeightPrecision,
 	}
 	// If API key is not provided, return empty client
 	if apiKey == nil {
@@ -59,10 +79,31 @@ func (c *BrickByBrickClient) doRequest(req *http.Request, apiKey *string) ([]byt
 	return body, err
 }
 
+// setQueryString sets a query parameter unless value is empty.
+func setQueryString(query url.Values, key string, value string) {
+	if value != "" {
+		query.Set(key, value)
+	}
+}
+
+// setQueryFloat sets a query parameter unless value is nil.
+func setQueryFloat(query url.Values, key string, value *float32) {
+	if value != nil {
+		query.Set(key, strconv.FormatFloat(float64(*value), 'f', -1, 32))
+	}
+}
+
+// setQueryInt sets a query parameter unless value is nil.
+func setQueryInt(query url.Values, key string, value *int32) {
+	if value != nil {
+		query.Set(key, strconv.FormatInt(int64(*value), 10))
+	}
+}
+
 // MARK: - Exercises
 
 func (c *BrickByBrickClient) GetExercise(exerciseId string) (*Exercise, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api/exercises/%s", exerciseId), nil)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/exercises/%s", c.HostURL, exerciseId), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -81,12 +122,41 @@ func (c *BrickByBrickClient) GetExercise(exerciseId string) (*Exercise, error) {
 	return &exercise, nil
 }
 
-func (c *BrickByBrickClient) GetExercises() ([]Exercise, error) {
-	req, err := http.NewRequest("GET", "https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api/exercises", nil)
+func (c *BrickByBrickClient) GetExercises(includeArchived bool) ([]Exercise, error) {
+	return c.ListExercises(ExerciseListOptions{IncludeArchived: includeArchived})
+}
+
+// ExerciseListOptions are sent to the API as query parameters to narrow and
+// order the exercises it lists. Weights are in lbs. The API may ignore any of
+// them, so callers should filter and sort the result again.
+type ExerciseListOptions struct {
+	IncludeArchived  bool
+	NamePrefix       string
+	MinDefaultWeight *float32
+	MaxDefaultWeight *float32
+	MuscleGroups     []string
+	SortBy           string
+	SortOrder        string
+}
+
+func (c *BrickByBrickClient) ListExercises(options ExerciseListOptions) ([]Exercise, error) {
+	req, err := http.NewRequest("GET", c.HostURL+"/exercises", nil)
 	if err != nil {
 		return nil, err
 	}
 
+	query := req.URL.Query()
+	if options.IncludeArchived {
+		query.Set("include_archived", "true")
+	}
+	setQueryString(query, "name_prefix", options.NamePrefix)
+	setQueryFloat(query, "min_default_weight", options.MinDefaultWeight)
+	setQueryFloat(query, "max_default_weight", options.MaxDefaultWeight)
+	setQueryString(query, "muscle_groups", strings.Join(options.MuscleGroups, ","))
+	setQueryString(query, "sort_by", options.SortBy)
+	setQueryString(query, "sort_order", options.SortOrder)
+	req.URL.RawQuery = query.Encode()
+
 	body, err := c.doRequest(req, nil)
 	if err != nil {
 		return nil, err
@@ -101,13 +171,31 @@ func (c *BrickByBrickClient) GetExercises() ([]Exercise, error) {
 	return exercises, nil
 }
 
+// FindExercisesByName returns the unarchived exercises whose name exactly
+// matches name.
+func (c *BrickByBrickClient) FindExercisesByName(name string) ([]Exercise, error) {
+	exercises, err := c.GetExercises(false)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []Exercise{}
+	for _, exercise := range exercises {
+		if exercise.Name == name {
+			matches = append(matches, exercise)
+		}
+	}
+
+	return matches, nil
+}
+
 func (c *BrickByBrickClient) CreateExercise(exercise Exercise) (*Exercise, error) {
 	rb, err := json.Marshal(exercise)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", "https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api/exercises", strings.NewReader(string(rb)))
+	req, err := http.NewRequest("POST", c.HostURL+"/exercises", strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +221,7 @@ func (c *BrickByBrickClient) UpdateExercise(exerciseIdStr string, exercise Exerc
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api/exercises/%s", exerciseIdStr), strings.NewReader(string(rb)))
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/exercises/%s", c.HostURL, exerciseIdStr), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -153,8 +241,46 @@ func (c *BrickByBrickClient) UpdateExercise(exerciseIdStr string, exercise Exerc
 	return &updatedExercise, nil
 }
 
+// PatchExercise sends only the given attributes of an exercise, leaving any
+// others untouched. It returns nil when the API does not respond with the
+// full updated exercise.
+func (c *BrickByBrickClient) PatchExercise(exerciseIdStr string, changes map[string]any) (*Exercise, error) {
+	rb, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/exercises/%s", c.HostURL, exerciseIdStr), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedExercise := Exercise{}
+	if err := json.Unmarshal(body, &patchedExercise); err != nil || patchedExercise.ID == 0 {
+		return nil, nil
+	}
+
+	return &patchedExercise, nil
+}
+
 func (c *BrickByBrickClient) DeleteExercise(exerciseIdStr string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api/exercises/%s", exerciseIdStr), nil)
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/exercises/%s", c.HostURL, exerciseIdStr), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req, nil)
+	return err
+}
+
+// ArchiveExercise hides an exercise from the app while keeping its logged
+// history.
+func (c *BrickByBrickClient) ArchiveExercise(exerciseIdStr string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/exercises/%s/archive", c.HostURL, exerciseIdStr), nil)
 	if err != nil {
 		return err
 	}
@@ -164,12 +290,43 @@ func (c *BrickByBrickClient) DeleteExercise(exerciseIdStr string) error {
 
 // MARK: - Strategies
 
-func (c *BrickByBrickClient) GetStrategies() ([]Strategy, error) {
-	req, err := http.NewRequest("GET", "https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api/strategies", nil)
+func (c *BrickByBrickClient) GetStrategies(includeArchived bool) ([]Strategy, error) {
+	return c.ListStrategies(StrategyListOptions{IncludeArchived: includeArchived})
+}
+
+// StrategyListOptions are sent to the API as query parameters to narrow and
+// order the strategies it lists. Weights are in lbs. The API may ignore any of
+// them, so callers should filter and sort the result again.
+type StrategyListOptions struct {
+	IncludeArchived        bool
+	DisplayNamePrefix      string
+	MinOverloadRate        *float32
+	MaxOverloadRate        *float32
+	MinExercisesPerWorkout *int32
+	MaxExercisesPerWorkout *int32
+	SortBy                 string
+	SortOrder              string
+}
+
+func (c *BrickByBrickClient) ListStrategies(options StrategyListOptions) ([]Strategy, error) {
+	req, err := http.NewRequest("GET", c.HostURL+"/strategies", nil)
 	if err != nil {
 		return nil, err
 	}
 
+	query := req.URL.Query()
+	if options.IncludeArchived {
+		query.Set("include_archived", "true")
+	}
+	setQueryString(query, "display_name_prefix", options.DisplayNamePrefix)
+	setQueryFloat(query, "min_overload_rate", options.MinOverloadRate)
+	setQueryFloat(query, "max_overload_rate", options.MaxOverloadRate)
+	setQueryInt(query, "min_exercises_per_workout", options.MinExercisesPerWorkout)
+	setQueryInt(query, "max_exercises_per_workout", options.MaxExercisesPerWorkout)
+	setQueryString(query, "sort_by", options.SortBy)
+	setQueryString(query, "sort_order", options.SortOrder)
+	req.URL.RawQuery = query.Encode()
+
 	body, err := c.doRequest(req, nil)
 	if err != nil {
 		return nil, err
@@ -184,8 +341,47 @@ func (c *BrickByBrickClient) GetStrategies() ([]Strategy, error) {
 	return strategies, nil
 }
 
+// FindStrategiesByDisplayName returns the unarchived strategies whose display
+// name exactly matches displayName.
+func (c *BrickByBrickClient) FindStrategiesByDisplayName(displayName string) ([]Strategy, error) {
+	strategies, err := c.GetStrategies(false)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []Strategy{}
+	for _, strategy := range strategies {
+		if strategy.DisplayName == displayName {
+			matches = append(matches, strategy)
+		}
+	}
+
+	return matches, nil
+}
+
 func (c *BrickByBrickClient) GetStrategy(strategyId string) (*Strategy, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api/strategies/%s", strategyId), nil)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/strategies/%s", c.HostURL, strategyId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := Strategy{}
+	err = json.Unmarshal(body, &strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &strategy, nil
+}
+
+// GetActiveStrategy returns the strategy the account is currently following.
+func (c *BrickByBrickClient) GetActiveStrategy() (*Strategy, error) {
+	req, err := http.NewRequest("GET", c.HostURL+"/strategies/active", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -210,7 +406,7 @@ func (c *BrickByBrickClient) CreateStrategy(strategy CreateStrategyPayload) (*St
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", "https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api/strategies", strings.NewReader(string(rb)))
+	req, err := http.NewRequest("POST", c.HostURL+"/strategies", strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +432,7 @@ func (c *BrickByBrickClient) UpdateStrategy(strategyIdStr string, strategy Creat
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api/strategies/%s", strategyIdStr), strings.NewReader(string(rb)))
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/strategies/%s", c.HostURL, strategyIdStr), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -256,8 +452,671 @@ func (c *BrickByBrickClient) UpdateStrategy(strategyIdStr string, strategy Creat
 	return &updatedStrategy, nil
 }
 
+// PatchStrategy sends only the given attributes of a strategy, leaving any
+// others untouched. It returns nil when the API does not respond with the
+// full updated strategy.
+func (c *BrickByBrickClient) PatchStrategy(strategyIdStr string, changes map[string]any) (*Strategy, error) {
+	rb, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/strategies/%s", c.HostURL, strategyIdStr), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedStrategy := Strategy{}
+	if err := json.Unmarshal(body, &patchedStrategy); err != nil || patchedStrategy.ID == 0 {
+		return nil, nil
+	}
+
+	return &patchedStrategy, nil
+}
+
 func (c *BrickByBrickClient) DeleteStrategy(strategyIdStr string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("https://mlsojdnlzcsczxwkeuwy.supabase.co/functions/v1/api/strategies/%s", strategyIdStr), nil)
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/strategies/%s", c.HostURL, strategyIdStr), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req, nil)
+	return err
+}
+
+// ArchiveStrategy hides a strategy from the app while keeping the history of
+// the workouts that used it.
+func (c *BrickByBrickClient) ArchiveStrategy(strategyIdStr string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/strategies/%s/archive", c.HostURL, strategyIdStr), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req, nil)
+	return err
+}
+
+// MARK: - Workout Templates
+
+func (c *BrickByBrickClient) GetWorkoutTemplate(workoutTemplateId string) (*WorkoutTemplate, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workout-templates/%s", c.HostURL, workoutTemplateId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	workoutTemplate := WorkoutTemplate{}
+	err = json.Unmarshal(body, &workoutTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workoutTemplate, nil
+}
+
+// ListWorkoutTemplates returns the workout templates of a strategy.
+func (c *BrickByBrickClient) ListWorkoutTemplates(strategyID int) ([]WorkoutTemplate, error) {
+	req, err := http.NewRequest("GET", c.HostURL+"/workout-templates", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.URL.Query()
+	query.Set("strategy_id", strconv.Itoa(strategyID))
+	req.URL.RawQuery = query.Encode()
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	workoutTemplates := []WorkoutTemplate{}
+	err = json.Unmarshal(body, &workoutTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	return workoutTemplates, nil
+}
+
+func (c *BrickByBrickClient) CreateWorkoutTemplate(workoutTemplate WorkoutTemplate) (*WorkoutTemplate, error) {
+	rb, err := json.Marshal(workoutTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.HostURL+"/workout-templates", strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	createdWorkoutTemplate := WorkoutTemplate{}
+	err = json.Unmarshal(body, &createdWorkoutTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("decoding workout template: %w", err)
+	}
+
+	return &createdWorkoutTemplate, nil
+}
+
+// PatchWorkoutTemplate sends only the given attributes of a workout template,
+// leaving any others untouched. It returns nil when the API does not respond
+// with the full updated workout template.
+func (c *BrickByBrickClient) PatchWorkoutTemplate(workoutTemplateIdStr string, changes map[string]any) (*WorkoutTemplate, error) {
+	rb, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/workout-templates/%s", c.HostURL, workoutTemplateIdStr), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedWorkoutTemplate := WorkoutTemplate{}
+	if err := json.Unmarshal(body, &patchedWorkoutTemplate); err != nil || patchedWorkoutTemplate.ID == 0 {
+		return nil, nil
+	}
+
+	return &patchedWorkoutTemplate, nil
+}
+
+func (c *BrickByBrickClient) DeleteWorkoutTemplate(workoutTemplateIdStr string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/workout-templates/%s", c.HostURL, workoutTemplateIdStr), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req, nil)
+	return err
+}
+
+// MARK: - Programs
+
+func (c *BrickByBrickClient) GetProgram(programId string) (*Program, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/programs/%s", c.HostURL, programId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	program := Program{}
+	err = json.Unmarshal(body, &program)
+	if err != nil {
+		return nil, err
+	}
+
+	return &program, nil
+}
+
+func (c *BrickByBrickClient) CreateProgram(program Program) (*Program, error) {
+	rb, err := json.Marshal(program)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.HostURL+"/programs", strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	createdProgram := Program{}
+	err = json.Unmarshal(body, &createdProgram)
+	if err != nil {
+		return nil, fmt.Errorf("decoding program: %w", err)
+	}
+
+	return &createdProgram, nil
+}
+
+// PatchProgram sends only the given attributes of a program, leaving any
+// others untouched. It returns nil when the API does not respond with the
+// full updated program.
+func (c *BrickByBrickClient) PatchProgram(programIdStr string, changes map[string]any) (*Program, error) {
+	rb, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/programs/%s", c.HostURL, programIdStr), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedProgram := Program{}
+	if err := json.Unmarshal(body, &patchedProgram); err != nil || patchedProgram.ID == 0 {
+		return nil, nil
+	}
+
+	return &patchedProgram, nil
+}
+
+func (c *BrickByBrickClient) DeleteProgram(programIdStr string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/programs/%s", c.HostURL, programIdStr), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req, nil)
+	return err
+}
+
+// MARK: - Schedules
+
+func (c *BrickByBrickClient) GetSchedule(scheduleId string) (*Schedule, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/schedules/%s", c.HostURL, scheduleId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := Schedule{}
+	err = json.Unmarshal(body, &schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+func (c *BrickByBrickClient) CreateSchedule(schedule Schedule) (*Schedule, error) {
+	rb, err := json.Marshal(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.HostURL+"/schedules", strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	createdSchedule := Schedule{}
+	err = json.Unmarshal(body, &createdSchedule)
+	if err != nil {
+		return nil, fmt.Errorf("decoding schedule: %w", err)
+	}
+
+	return &createdSchedule, nil
+}
+
+// PatchSchedule sends only the given attributes of a schedule, leaving any
+// others untouched. It returns nil when the API does not respond with the
+// full updated schedule.
+func (c *BrickByBrickClient) PatchSchedule(scheduleIdStr string, changes map[string]any) (*Schedule, error) {
+	rb, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/schedules/%s", c.HostURL, scheduleIdStr), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedSchedule := Schedule{}
+	if err := json.Unmarshal(body, &patchedSchedule); err != nil || patchedSchedule.ID == 0 {
+		return nil, nil
+	}
+
+	return &patchedSchedule, nil
+}
+
+func (c *BrickByBrickClient) DeleteSchedule(scheduleIdStr string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/schedules/%s", c.HostURL, scheduleIdStr), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req, nil)
+	return err
+}
+
+// MARK: - Workout Sessions
+
+type WorkoutSessionListOptions struct {
+	From        string
+	To          string
+	ExerciseIDs []int
+	StrategyID  int
+	Limit       int32
+	Offset      int32
+}
+
+// ListWorkoutSessions returns one page of the workout sessions logged between
+// From and To, inclusive, oldest first.
+func (c *BrickByBrickClient) ListWorkoutSessions(options WorkoutSessionListOptions) (*WorkoutSessionPage, error) {
+	req, err := http.NewRequest("GET", c.HostURL+"/workout-sessions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	exerciseIDs := make([]string, 0, len(options.ExerciseIDs))
+	for _, exerciseID := range options.ExerciseIDs {
+		exerciseIDs = append(exerciseIDs, strconv.Itoa(exerciseID))
+	}
+
+	query := req.URL.Query()
+	setQueryString(query, "from", options.From)
+	setQueryString(query, "to", options.To)
+	setQueryString(query, "exercise_ids", strings.Join(exerciseIDs, ","))
+	if options.StrategyID != 0 {
+		query.Set("strategy_id", strconv.Itoa(options.StrategyID))
+	}
+	if options.Limit > 0 {
+		setQueryInt(query, "limit", &options.Limit)
+	}
+	setQueryInt(query, "offset", &options.Offset)
+	req.URL.RawQuery = query.Encode()
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	page := WorkoutSessionPage{}
+	err = json.Unmarshal(body, &page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// workoutSessionsPageLimit is the largest page ListAllWorkoutSessions asks for.
+const workoutSessionsPageLimit = 500
+
+// ListAllWorkoutSessions returns every workout session logged between From
+// and To, inclusive, oldest first, fetching as many pages as it takes. Limit
+// and Offset are ignored.
+func (c *BrickByBrickClient) ListAllWorkoutSessions(options WorkoutSessionListOptions) ([]WorkoutSession, error) {
+	sessions := []WorkoutSession{}
+	options.Limit = workoutSessionsPageLimit
+	options.Offset = 0
+	for {
+		page, err := c.ListWorkoutSessions(options)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, page.Sessions...)
+		if len(page.Sessions) == 0 || int32(len(sessions)) >= page.TotalCount {
+			return sessions, nil
+		}
+		// The API may return fewer sessions than asked for, so continue from
+		// the last one received.
+		options.Offset += int32(len(page.Sessions))
+	}
+}
+
+// MARK: - Exercise Groups
+
+func (c *BrickByBrickClient) GetExerciseGroup(exerciseGroupId string) (*ExerciseGroup, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/exercise-groups/%s", c.HostURL, exerciseGroupId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	exerciseGroup := ExerciseGroup{}
+	err = json.Unmarshal(body, &exerciseGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &exerciseGroup, nil
+}
+
+// ListExerciseGroups returns the exercise groups of a workout template.
+func (c *BrickByBrickClient) ListExerciseGroups(workoutTemplateID int) ([]ExerciseGroup, error) {
+	req, err := http.NewRequest("GET", c.HostURL+"/exercise-groups", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.URL.Query()
+	query.Set("workout_template_id", strconv.Itoa(workoutTemplateID))
+	req.URL.RawQuery = query.Encode()
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	exerciseGroups := []ExerciseGroup{}
+	err = json.Unmarshal(body, &exerciseGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	return exerciseGroups, nil
+}
+
+func (c *BrickByBrickClient) CreateExerciseGroup(exerciseGroup ExerciseGroup) (*ExerciseGroup, error) {
+	rb, err := json.Marshal(exerciseGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.HostURL+"/exercise-groups", strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	createdExerciseGroup := ExerciseGroup{}
+	err = json.Unmarshal(body, &createdExerciseGroup)
+	if err != nil {
+		return nil, fmt.Errorf("decoding exercise group: %w", err)
+	}
+
+	return &createdExerciseGroup, nil
+}
+
+// PatchExerciseGroup sends only the given attributes of an exercise group,
+// leaving any others untouched. It returns nil when the API does not respond
+// with the full updated exercise group.
+func (c *BrickByBrickClient) PatchExerciseGroup(exerciseGroupIdStr string, changes map[string]any) (*ExerciseGroup, error) {
+	rb, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/exercise-groups/%s", c.HostURL, exerciseGroupIdStr), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedExerciseGroup := ExerciseGroup{}
+	if err := json.Unmarshal(body, &patchedExerciseGroup); err != nil || patchedExerciseGroup.ID == 0 {
+		return nil, nil
+	}
+
+	return &patchedExerciseGroup, nil
+}
+
+func (c *BrickByBrickClient) DeleteExerciseGroup(exerciseGroupIdStr string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/exercise-groups/%s", c.HostURL, exerciseGroupIdStr), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req, nil)
+	return err
+}
+
+// MARK: - Equipment Profiles
+
+func (c *BrickByBrickClient) GetEquipmentProfile(equipmentProfileId string) (*EquipmentProfile, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/equipment-profiles/%s", c.HostURL, equipmentProfileId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	equipmentProfile := EquipmentProfile{}
+	err = json.Unmarshal(body, &equipmentProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &equipmentProfile, nil
+}
+
+func (c *BrickByBrickClient) CreateEquipmentProfile(equipmentProfile EquipmentProfile) (*EquipmentProfile, error) {
+	rb, err := json.Marshal(equipmentProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.HostURL+"/equipment-profiles", strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	createdEquipmentProfile := EquipmentProfile{}
+	err = json.Unmarshal(body, &createdEquipmentProfile)
+	if err != nil {
+		return nil, fmt.Errorf("decoding equipment profile: %w", err)
+	}
+
+	return &createdEquipmentProfile, nil
+}
+
+// PatchEquipmentProfile sends only the given attributes of an equipment profile,
+// leaving any others untouched. It returns nil when the API does not respond
+// with the full updated equipment profile.
+func (c *BrickByBrickClient) PatchEquipmentProfile(equipmentProfileIdStr string, changes map[string]any) (*EquipmentProfile, error) {
+	rb, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/equipment-profiles/%s", c.HostURL, equipmentProfileIdStr), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedEquipmentProfile := EquipmentProfile{}
+	if err := json.Unmarshal(body, &patchedEquipmentProfile); err != nil || patchedEquipmentProfile.ID == 0 {
+		return nil, nil
+	}
+
+	return &patchedEquipmentProfile, nil
+}
+
+func (c *BrickByBrickClient) DeleteEquipmentProfile(equipmentProfileIdStr string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/equipment-profiles/%s", c.HostURL, equipmentProfileIdStr), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(req, nil)
+	return err
+}
+
+// MARK: - Goals
+
+func (c *BrickByBrickClient) GetGoal(goalId string) (*Goal, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/goals/%s", c.HostURL, goalId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	goal := Goal{}
+	err = json.Unmarshal(body, &goal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &goal, nil
+}
+
+func (c *BrickByBrickClient) CreateGoal(goal Goal) (*Goal, error) {
+	rb, err := json.Marshal(goal)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.HostURL+"/goals", strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	createdGoal := Goal{}
+	err = json.Unmarshal(body, &createdGoal)
+	if err != nil {
+		return nil, fmt.Errorf("decoding goal: %w", err)
+	}
+
+	return &createdGoal, nil
+}
+
+// PatchGoal sends only the given attributes of a goal, leaving any others
+// untouched. It returns nil when the API does not respond with the full
+// updated goal.
+func (c *BrickByBrickClient) PatchGoal(goalIdStr string, changes map[string]any) (*Goal, error) {
+	rb, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/goals/%s", c.HostURL, goalIdStr), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedGoal := Goal{}
+	if err := json.Unmarshal(body, &patchedGoal); err != nil || patchedGoal.ID == 0 {
+		return nil, nil
+	}
+
+	return &patchedGoal, nil
+}
+
+func (c *BrickByBrickClient) DeleteGoal(goalIdStr string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/goals/%s", c.HostURL, goalIdStr), nil)
 	if err != nil {
 		return err
 	}
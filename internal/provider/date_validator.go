@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// dateLayout is the format of calendar dates, such as 2026-03-01, in
+// configuration and in the API.
+const dateLayout = time.DateOnly
+
+var _ validator.String = dateValidator{}
+
+// dateValidator checks that a string is a calendar date in dateLayout.
+type dateValidator struct{}
+
+func (v dateValidator) Description(_ context.Context) string {
+	return "value must be a date in YYYY-MM-DD format"
+}
+
+func (v dateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dateValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(dateLayout, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Date",
+			"Expected a date in YYYY-MM-DD format, got "+req.ConfigValue.String()+".",
+		)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resolveDeletionProtection returns the deletion protection used when a
+// resource does not configure deletion_protection: the provider-wide
+// default_deletion_protection if set, otherwise resourceDefault.
+func resolveDeletionProtection(client *BrickByBrickClient, resourceDefault bool) bool {
+	if client != nil && client.DefaultDeletionProtection != nil {
+		return *client.DefaultDeletionProtection
+	}
+	return resourceDefault
+}
+
+// planDeletionProtection sets deletion_protection in the plan to its default
+// when the configuration leaves it unset.
+func planDeletionProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, client *BrickByBrickClient, resourceDefault bool) {
+	var configured types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deletion_protection"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	planned := types.BoolValue(resolveDeletionProtection(client, resourceDefault))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), planned)...)
+}
+
+// deletionProtectionReplaceWarning warns that an object with deletion
+// protection is planned for replacement, which will fail when the existing
+// object is deleted.
+func deletionProtectionReplaceWarning(objectType string, name string) diag.Diagnostic {
+	return diag.NewAttributeWarningDiagnostic(
+		path.Root("deletion_protection"),
+		"Protected "+objectType+" Planned For Replacement",
+		"The "+objectType+" "+name+" has deletion_protection enabled but is planned for replacement. "+
+			"The apply will fail when Terraform tries to delete it. "+
+			"Set deletion_protection = false and apply that change first if the replacement is intended.",
+	)
+}
+
+// deletionProtectionError is returned by Delete when the object is protected.
+func deletionProtectionError(objectType string, name string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root("deletion_protection"),
+		"Cannot Delete Protected BrickByBrick "+objectType,
+		"The "+objectType+" "+name+" has deletion_protection enabled, so it was not deleted. "+
+			"Set deletion_protection = false, or on_destroy = \"archive\" to keep its history, and apply that change before destroying it.",
+	)
+}
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const (
+	// unloadableWeightReject rejects weights that the equipment profile
+	// cannot load.
+	unloadableWeightReject = "reject"
+
+	// unloadableWeightSnap loads the nearest weight the equipment profile can
+	// load instead.
+	unloadableWeightSnap = "snap"
+)
+
+// unloadableWeightActions are the values accepted by on_unloadable_weight.
+var unloadableWeightActions = []string{unloadableWeightReject, unloadableWeightSnap}
+
+const (
+	equipmentKindBarbells     = "barbells"
+	equipmentKindDumbbellSets = "dumbbell_sets"
+	equipmentKindMachines     = "machines"
+)
+
+// barbellEquipment are the values of an exercise's equipment that are loaded
+// with plates on one of the profile's barbells.
+var barbellEquipment = []string{"barbell", "ez_bar", "smith_machine", "trap_bar"}
+
+// equipmentKind returns the attribute of an equipment profile that loads an
+// exercise using equipment, or the empty string when its weight is not
+// limited by the profile, such as for bodyweight exercises.
+func equipmentKind(equipment string) string {
+	switch {
+	case slices.Contains(barbellEquipment, equipment):
+		return equipmentKindBarbells
+	case equipment == "dumbbell":
+		return equipmentKindDumbbellSets
+	case equipment == "machine" || equipment == "cable":
+		return equipmentKindMachines
+	default:
+		return ""
+	}
+}
+
+// nearestLoadableWeight returns the weight closest to weight that the profile
+// can load for an exercise using equipment, preferring the lighter of two
+// equally close weights. Weights are in the profile's weight unit. It returns
+// false when the profile has none of the equipment the exercise needs.
+func nearestLoadableWeight(profile *EquipmentProfile, equipment string, weight float32) (float32, bool) {
+	target := toHundredths(weight)
+	var best int64
+	found := false
+	consider := func(candidate int64) {
+		if !found || absInt64(candidate-target) < absInt64(best-target) ||
+			(absInt64(candidate-target) == absInt64(best-target) && candidate < best) {
+			best = candidate
+			found = true
+		}
+	}
+
+	switch equipmentKind(equipment) {
+	case equipmentKindBarbells:
+		sides := plateSums(profile.Plates)
+		for _, barbell := range profile.Barbells {
+			bar := toHundredths(barbell.Weight)
+			for _, side := range sides {
+				consider(bar + 2*side)
+			}
+		}
+	case equipmentKindDumbbellSets:
+		for _, dumbbellSet := range profile.DumbbellSets {
+			consider(nearestInRange(dumbbellSet, target))
+		}
+	case equipmentKindMachines:
+		for _, machine := range profile.Machines {
+			consider(nearestInRange(machine, target))
+		}
+	default:
+		return weight, true
+	}
+
+	if !found {
+		return 0, false
+	}
+	return fromHundredths(best), true
+}
+
+// loadableDefaultWeight returns the default weight, in weightUnit, that the
+// profile loads for an exercise using equipment. A weight the profile cannot
+// load is rejected or snapped to the nearest loadable weight, according to the
+// profile's on_unloadable_weight.
+func loadableDefaultWeight(profile *EquipmentProfile, equipment string, weight float32, weightUnit string) (float32, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	nearest, ok := nearestLoadableWeight(profile, equipment, convertWeight(weight, weightUnit, profile.WeightUnit))
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("equipment_profile_id"),
+			"No Matching Equipment",
+			fmt.Sprintf("Equipment profile %q (ID %d) has no %s to load a %s exercise. Add them to the profile or reference a different one.",
+				profile.Name, profile.ID, equipmentKind(equipment), equipment),
+		)
+		return weight, diags
+	}
+	if isLoadable(convertWeight(weight, weightUnit, profile.WeightUnit), nearest) {
+		return weight, diags
+	}
+
+	// Rounded to the precision of the API rather than weight_precision, as a
+	// coarser precision could make the snapped weight unloadable again.
+	snapped := roundToPrecision(convertWeight(nearest, profile.WeightUnit, weightUnit), defaultWeightPrecision)
+	if profile.OnUnloadableWeight == unloadableWeightSnap {
+		diags.AddAttributeWarning(
+			path.Root("default_weight"),
+			"Default Weight Snapped To Loadable Weight",
+			fmt.Sprintf("Equipment profile %q cannot load %g %s, so %g %s will be loaded instead. It is available in loadable_default_weight.",
+				profile.Name, weight, weightUnit, snapped, weightUnit),
+		)
+		return snapped, diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("default_weight"),
+		"Default Weight Cannot Be Loaded",
+		fmt.Sprintf("Equipment profile %q cannot load %g %s. The nearest weight it can load is %g %s.",
+			profile.Name, weight, weightUnit, snapped, weightUnit),
+	)
+	return weight, diags
+}
+
+// loadableOverloadRate returns the overload rate, in weightUnit, that can be
+// added on every piece of equipment in the profile. A rate that is not a
+// multiple of the profile's increment is rejected or snapped to the nearest
+// multiple, according to the profile's on_unloadable_weight.
+func loadableOverloadRate(profile *EquipmentProfile, overloadRate float32, weightUnit string) (float32, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	increment := equipmentIncrement(profile)
+	rate := convertWeight(overloadRate, weightUnit, profile.WeightUnit)
+	nearest := nearestMultiple(rate, increment)
+	if isLoadable(rate, nearest) {
+		return overloadRate, diags
+	}
+
+	snapped := roundToPrecision(convertWeight(nearest, profile.WeightUnit, weightUnit), defaultWeightPrecision)
+	if profile.OnUnloadableWeight == unloadableWeightSnap {
+		diags.AddAttributeWarning(
+			path.Root("overload_rate"),
+			"Overload Rate Snapped To Loadable Increment",
+			fmt.Sprintf("Equipment profile %q can only add weight in steps of %g %s, so an overload rate of %g %s will be applied instead of %g %s. It is available in loadable_overload_rate.",
+				profile.Name, increment, profile.WeightUnit, snapped, weightUnit, overloadRate, weightUnit),
+		)
+		return snapped, diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("overload_rate"),
+		"Overload Rate Cannot Be Loaded",
+		fmt.Sprintf("Equipment profile %q can only add weight in steps of %g %s, so an overload rate of %g %s cannot be loaded. The nearest rate that can is %g %s.",
+			profile.Name, increment, profile.WeightUnit, overloadRate, weightUnit, snapped, weightUnit),
+	)
+	return overloadRate, diags
+}
+
+// equipmentIncrement returns the smallest weight that can be added on every
+// piece of equipment in the profile, in the profile's weight unit, or 0 when
+// the profile has no equipment that can be loaded in steps.
+func equipmentIncrement(profile *EquipmentProfile) float32 {
+	var steps []int64
+	if len(profile.Barbells) > 0 && len(profile.Plates) > 0 {
+		var plateStep int64
+		for _, plate := range profile.Plates {
+			plateStep = gcdInt64(plateStep, toHundredths(plate.Weight))
+		}
+		// Plates are added in pairs, one on each side.
+		steps = append(steps, 2*plateStep)
+	}
+	for _, weightRange := range slices.Concat(profile.DumbbellSets, profile.Machines) {
+		steps = append(steps, toHundredths(weightRange.Increment))
+	}
+
+	var increment int64
+	for _, step := range steps {
+		if step <= 0 {
+			continue
+		}
+		if increment == 0 {
+			increment = step
+			continue
+		}
+		increment = increment / gcdInt64(increment, step) * step
+	}
+	return fromHundredths(increment)
+}
+
+// nearestMultiple returns the multiple of increment closest to value,
+// preferring the smaller of two equally close multiples. A positive value is
+// never rounded down to 0.
+func nearestMultiple(value, increment float32) float32 {
+	v, step := toHundredths(value), toHundredths(increment)
+	if step <= 0 {
+		return value
+	}
+
+	multiples := v / step
+	if 2*(v%step) > step {
+		multiples++
+	}
+	if multiples == 0 && v > 0 {
+		multiples = 1
+	}
+	return fromHundredths(multiples * step)
+}
+
+// isLoadable reports whether loadable is the same weight as weight, to the
+// hundredth of a unit that equipment weights are compared in.
+func isLoadable(weight, loadable float32) bool {
+	return toHundredths(weight) == toHundredths(loadable)
+}
+
+// plateSums returns every total weight, in hundredths, that can be loaded on
+// one side of a barbell with one plate of each pair, including no plates.
+func plateSums(plates []Plate) []int64 {
+	var step int64
+	for _, plate := range plates {
+		step = gcdInt64(step, toHundredths(plate.Weight))
+	}
+	if step == 0 {
+		return []int64{0}
+	}
+
+	// Track reachable totals in units of step to keep the table small.
+	var total int64
+	for _, plate := range plates {
+		total += toHundredths(plate.Weight) / step * int64(plate.Pairs)
+	}
+	reachable := make([]bool, total+1)
+	reachable[0] = true
+	for _, plate := range plates {
+		units := toHundredths(plate.Weight) / step
+		for range plate.Pairs {
+			for sum := total; sum >= units; sum-- {
+				if reachable[sum-units] {
+					reachable[sum] = true
+				}
+			}
+		}
+	}
+
+	var sums []int64
+	for sum, ok := range reachable {
+		if ok {
+			sums = append(sums, int64(sum)*step)
+		}
+	}
+	return sums
+}
+
+// nearestInRange returns the weight of weightRange closest to target, in
+// hundredths, preferring the lighter of two equally close weights.
+func nearestInRange(weightRange WeightRange, target int64) int64 {
+	minimum, maximum, step := toHundredths(weightRange.MinWeight), toHundredths(weightRange.MaxWeight), toHundredths(weightRange.Increment)
+	if target <= minimum || step <= 0 {
+		return minimum
+	}
+
+	heaviest := minimum + (maximum-minimum)/step*step
+	if target >= heaviest {
+		return heaviest
+	}
+
+	lower := minimum + (target-minimum)/step*step
+	if target-lower > lower+step-target {
+		return lower + step
+	}
+	return lower
+}
+
+// toHundredths converts a weight into whole hundredths of its unit, so that
+// equipment weights can be compared without floating point error.
+func toHundredths(weight float32) int64 {
+	return int64(math.Round(float64(weight) * 100))
+}
+
+func fromHundredths(hundredths int64) float32 {
+	return float32(float64(hundredths) / 100)
+}
+
+func gcdInt64(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return absInt64(a)
+}
+
+func absInt64(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
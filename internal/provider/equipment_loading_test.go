@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestNearestLoadableWeight(t *testing.T) {
+	profile := &EquipmentProfile{
+		Name:       "Garage",
+		WeightUnit: weightUnitPounds,
+		Barbells: []Barbell{
+			{Name: "Olympic", Weight: 45},
+			{Name: "Technique", Weight: 15},
+		},
+		Plates: []Plate{
+			{Weight: 45, Pairs: 2},
+			{Weight: 10, Pairs: 1},
+			{Weight: 2.5, Pairs: 1},
+		},
+		DumbbellSets: []WeightRange{
+			{Name: "Adjustable", MinWeight: 5, MaxWeight: 52.5, Increment: 2.5},
+		},
+	}
+
+	testCases := map[string]struct {
+		equipment string
+		weight    float32
+		want      float32
+		wantOk    bool
+	}{
+		"loadable-barbell": {
+			equipment: "barbell",
+			weight:    160,
+			want:      160,
+			wantOk:    true,
+		},
+		"between-plates": {
+			equipment: "barbell",
+			weight:    37.3,
+			want:      35,
+			wantOk:    true,
+		},
+		"tie-prefers-lighter": {
+			equipment: "barbell",
+			weight:    47.5,
+			want:      45,
+			wantOk:    true,
+		},
+		"above-heaviest-load": {
+			equipment: "barbell",
+			weight:    500,
+			want:      250,
+			wantOk:    true,
+		},
+		"dumbbell-snaps-to-step": {
+			equipment: "dumbbell",
+			weight:    37.3,
+			want:      37.5,
+			wantOk:    true,
+		},
+		"dumbbell-below-range": {
+			equipment: "dumbbell",
+			weight:    1,
+			want:      5,
+			wantOk:    true,
+		},
+		"no-machines": {
+			equipment: "machine",
+			weight:    100,
+			wantOk:    false,
+		},
+		"unlimited-equipment": {
+			equipment: "bodyweight",
+			weight:    37.3,
+			want:      37.3,
+			wantOk:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := nearestLoadableWeight(profile, testCase.equipment, testCase.weight)
+			if ok != testCase.wantOk || (ok && got != testCase.want) {
+				t.Errorf("nearestLoadableWeight(%q, %g) = %g, %t, want %g, %t",
+					testCase.equipment, testCase.weight, got, ok, testCase.want, testCase.wantOk)
+			}
+		})
+	}
+}
+
+func TestEquipmentIncrement(t *testing.T) {
+	testCases := map[string]struct {
+		profile *EquipmentProfile
+		want    float32
+	}{
+		"empty": {
+			profile: &EquipmentProfile{},
+			want:    0,
+		},
+		"plates-without-barbell": {
+			profile: &EquipmentProfile{Plates: []Plate{{Weight: 2.5, Pairs: 1}}},
+			want:    0,
+		},
+		"barbell": {
+			profile: &EquipmentProfile{
+				Barbells: []Barbell{{Name: "Olympic", Weight: 20}},
+				Plates:   []Plate{{Weight: 20, Pairs: 4}, {Weight: 1.25, Pairs: 1}},
+			},
+			want: 2.5,
+		},
+		"common-multiple": {
+			profile: &EquipmentProfile{
+				Barbells: []Barbell{{Name: "Olympic", Weight: 45}},
+				Plates:   []Plate{{Weight: 2.5, Pairs: 1}},
+				Machines: []WeightRange{{Name: "Cable", MinWeight: 10, MaxWeight: 200, Increment: 7.5}},
+			},
+			want: 15,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := equipmentIncrement(testCase.profile); got != testCase.want {
+				t.Errorf("equipmentIncrement() = %g, want %g", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestLoadableOverloadRate(t *testing.T) {
+	profile := &EquipmentProfile{
+		Name:       "Gym",
+		WeightUnit: weightUnitKilograms,
+		Barbells:   []Barbell{{Name: "Olympic", Weight: 20}},
+		Plates:     []Plate{{Weight: 1.25, Pairs: 1}},
+	}
+
+	testCases := map[string]struct {
+		onUnloadableWeight string
+		overloadRate       float32
+		weightUnit         string
+		want               float32
+		wantError          bool
+		wantWarning        bool
+	}{
+		"multiple": {
+			onUnloadableWeight: unloadableWeightReject,
+			overloadRate:       5,
+			weightUnit:         weightUnitKilograms,
+			want:               5,
+		},
+		"reject": {
+			onUnloadableWeight: unloadableWeightReject,
+			overloadRate:       1,
+			weightUnit:         weightUnitKilograms,
+			want:               1,
+			wantError:          true,
+		},
+		"snap": {
+			onUnloadableWeight: unloadableWeightSnap,
+			overloadRate:       4,
+			weightUnit:         weightUnitKilograms,
+			want:               5,
+			wantWarning:        true,
+		},
+		"snap-never-to-zero": {
+			onUnloadableWeight: unloadableWeightSnap,
+			overloadRate:       0.5,
+			weightUnit:         weightUnitKilograms,
+			want:               2.5,
+			wantWarning:        true,
+		},
+		"snap-in-other-unit": {
+			onUnloadableWeight: unloadableWeightSnap,
+			overloadRate:       5,
+			weightUnit:         weightUnitPounds,
+			want:               5.51,
+			wantWarning:        true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			profile.OnUnloadableWeight = testCase.onUnloadableWeight
+			got, diags := loadableOverloadRate(profile, testCase.overloadRate, testCase.weightUnit)
+			if got != testCase.want {
+				t.Errorf("loadableOverloadRate(%g %s) = %g, want %g", testCase.overloadRate, testCase.weightUnit, got, testCase.want)
+			}
+			if diags.HasError() != testCase.wantError {
+				t.Errorf("loadableOverloadRate(%g %s) error = %t, want %t", testCase.overloadRate, testCase.weightUnit, diags.HasError(), testCase.wantError)
+			}
+			if (diags.WarningsCount() > 0) != testCase.wantWarning {
+				t.Errorf("loadableOverloadRate(%g %s) warnings = %d, want warning %t", testCase.overloadRate, testCase.weightUnit, diags.WarningsCount(), testCase.wantWarning)
+			}
+		})
+	}
+}
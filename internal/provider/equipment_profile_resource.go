@@ -0,0 +1,655 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &equipmentProfileResource{}
+	_ resource.ResourceWithConfigure   = &equipmentProfileResource{}
+	_ resource.ResourceWithImportState = &equipmentProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &equipmentProfileResource{}
+)
+
+// barbellAttrTypes are the attribute types of an entry in barbells.
+var barbellAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"weight": types.Float32Type,
+}
+
+// plateAttrTypes are the attribute types of an entry in plates.
+var plateAttrTypes = map[string]attr.Type{
+	"weight": types.Float32Type,
+	"pairs":  types.Int32Type,
+}
+
+// weightRangeAttrTypes are the attribute types of an entry in dumbbell_sets
+// or machines.
+var weightRangeAttrTypes = map[string]attr.Type{
+	"name":       types.StringType,
+	"min_weight": types.Float32Type,
+	"max_weight": types.Float32Type,
+	"increment":  types.Float32Type,
+}
+
+// NewEquipmentProfileResource is a helper function to simplify the provider implementation.
+func NewEquipmentProfileResource() resource.Resource {
+	return &equipmentProfileResource{}
+}
+
+// equipmentProfileResource is the resource implementation.
+type equipmentProfileResource struct {
+	client *BrickByBrickClient
+}
+
+type equipmentProfileResourceModel struct {
+	ID                 types.String  `tfsdk:"id"`
+	EquipmentProfileID types.Int64   `tfsdk:"equipment_profile_id"`
+	Name               types.String  `tfsdk:"name"`
+	WeightUnit         types.String  `tfsdk:"weight_unit"`
+	Barbells           types.List    `tfsdk:"barbells"`
+	Plates             types.List    `tfsdk:"plates"`
+	DumbbellSets       types.List    `tfsdk:"dumbbell_sets"`
+	Machines           types.List    `tfsdk:"machines"`
+	OnUnloadableWeight types.String  `tfsdk:"on_unloadable_weight"`
+	Increment          types.Float32 `tfsdk:"increment"`
+	CreatedAt          types.String  `tfsdk:"created_at"`
+	UpdatedAt          types.String  `tfsdk:"updated_at"`
+	OwnerID            types.String  `tfsdk:"owner_id"`
+}
+
+type barbellModel struct {
+	Name   types.String  `tfsdk:"name"`
+	Weight types.Float32 `tfsdk:"weight"`
+}
+
+type plateModel struct {
+	Weight types.Float32 `tfsdk:"weight"`
+	Pairs  types.Int32   `tfsdk:"pairs"`
+}
+
+type weightRangeModel struct {
+	Name      types.String  `tfsdk:"name"`
+	MinWeight types.Float32 `tfsdk:"min_weight"`
+	MaxWeight types.Float32 `tfsdk:"max_weight"`
+	Increment types.Float32 `tfsdk:"increment"`
+}
+
+// Metadata returns the resource type name.
+func (r *equipmentProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_equipment_profile"
+}
+
+// Create a new resource.
+func (r *equipmentProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan equipmentProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	newEquipmentProfile, diags := plan.equipmentProfile(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdEquipmentProfile, err := r.client.CreateEquipmentProfile(*newEquipmentProfile)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating equipment profile",
+			"Could not create equipment profile, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(plan.refresh(ctx, createdEquipmentProfile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *equipmentProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state equipmentProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedEquipmentProfile, err := r.client.GetEquipmentProfile(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading BrickByBrick Equipment Profile",
+			"Could not read BrickByBrick equipment profile ID "+state.ID.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(state.refresh(ctx, refreshedEquipmentProfile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *equipmentProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan equipmentProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state equipmentProfileResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from the attributes that changed
+	planEquipmentProfile, diags := plan.equipmentProfile(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lists removed from the configuration are sent empty so the API clears
+	// them.
+	changes := map[string]any{}
+	if !plan.Name.Equal(state.Name) {
+		changes["name"] = planEquipmentProfile.Name
+	}
+	if !plan.WeightUnit.Equal(state.WeightUnit) {
+		changes["weight_unit"] = planEquipmentProfile.WeightUnit
+	}
+	if !plan.Barbells.Equal(state.Barbells) {
+		changes["barbells"] = emptyIfNil(planEquipmentProfile.Barbells)
+	}
+	if !plan.Plates.Equal(state.Plates) {
+		changes["plates"] = emptyIfNil(planEquipmentProfile.Plates)
+	}
+	if !plan.DumbbellSets.Equal(state.DumbbellSets) {
+		changes["dumbbell_sets"] = emptyIfNil(planEquipmentProfile.DumbbellSets)
+	}
+	if !plan.Machines.Equal(state.Machines) {
+		changes["machines"] = emptyIfNil(planEquipmentProfile.Machines)
+	}
+	if !plan.OnUnloadableWeight.Equal(state.OnUnloadableWeight) {
+		changes["on_unloadable_weight"] = planEquipmentProfile.OnUnloadableWeight
+	}
+
+	var equipmentProfile *EquipmentProfile
+	if len(changes) > 0 {
+		var err error
+		equipmentProfile, err = r.client.PatchEquipmentProfile(plan.ID.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating equipment profile",
+				"Could not update equipment profile, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Only fetch the equipment profile when nothing was sent or the PATCH
+	// response did not include it.
+	if equipmentProfile == nil {
+		var err error
+		equipmentProfile, err = r.client.GetEquipmentProfile(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading equipment profile",
+				"Could not read equipment profile ID "+plan.ID.String()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(plan.refresh(ctx, equipmentProfile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *equipmentProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state equipmentProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteEquipmentProfile(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting BrickByBrick Equipment Profile",
+			"Could not delete equipment profile, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *equipmentProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *BrickByBrickClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *equipmentProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	weightRangeAttributes := func(kind string, nameRequired bool) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    nameRequired,
+				Optional:    !nameRequired,
+				Description: "The name of the " + kind + ".",
+			},
+			"min_weight": schema.Float32Attribute{
+				Required:    true,
+				Description: "The lightest weight of the " + kind + ", in weight_unit.",
+				Validators: []validator.Float32{
+					float32validator.AtLeast(0),
+				},
+			},
+			"max_weight": schema.Float32Attribute{
+				Required:    true,
+				Description: "The heaviest weight of the " + kind + ", in weight_unit.",
+			},
+			"increment": schema.Float32Attribute{
+				Required:    true,
+				Description: "The step between consecutive weights of the " + kind + ", in weight_unit.",
+				Validators: []validator.Float32{
+					float32validator.AtLeast(0.25),
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "The equipment an athlete can load. Exercises and strategies that reference the profile have weights and overload rates that cannot be loaded rejected or snapped to the nearest loadable value.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the equipment profile",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"equipment_profile_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The unique identifier for the equipment profile as a number.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the equipment profile.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"weight_unit": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unit, lb or kg, of every weight in the profile. Unlike other resources, the weights are stored in this unit rather than converted, so changing it changes the equipment described. Defaults to the provider weight_unit when the profile is created.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"barbells": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The barbells available. Exercises using a barbell, ez_bar, smith_machine or trap_bar are loaded with plates on any of them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the barbell.",
+						},
+						"weight": schema.Float32Attribute{
+							Required:    true,
+							Description: "The weight of the empty barbell, in weight_unit.",
+							Validators: []validator.Float32{
+								float32validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"plates": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The plates available for the barbells.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(20),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"weight": schema.Float32Attribute{
+							Required:    true,
+							Description: "The weight of one plate, in weight_unit.",
+							Validators: []validator.Float32{
+								float32validator.Between(0.25, 100),
+							},
+						},
+						"pairs": schema.Int32Attribute{
+							Required:    true,
+							Description: "The number of pairs of this plate, one plate for each side of the barbell.",
+							Validators: []validator.Int32{
+								int32validator.Between(1, 20),
+							},
+						},
+					},
+				},
+			},
+			"dumbbell_sets": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The dumbbell sets or adjustable dumbbells available to exercises using a dumbbell.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: weightRangeAttributes("dumbbell set", false),
+					Validators: []validator.Object{
+						weightRangeValidator{},
+					},
+				},
+			},
+			"machines": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The weight stack machines available to exercises using a machine or cable.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: weightRangeAttributes("machine", true),
+					Validators: []validator.Object{
+						weightRangeValidator{},
+					},
+				},
+			},
+			"on_unloadable_weight": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(unloadableWeightReject),
+				Description: "What to do with a weight or overload rate that this equipment cannot load: reject it with an error at plan time, or snap it to the nearest value that can be loaded and warn. Defaults to reject.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(unloadableWeightActions...),
+				},
+			},
+			"increment": schema.Float32Attribute{
+				Computed:    true,
+				Description: "The smallest weight, in weight_unit, that can be added on every barbell, dumbbell set and machine in the profile. Overload rates of strategies that reference the profile must be a multiple of it.",
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the equipment profile was created, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the equipment profile was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The identifier of the account that owns the equipment profile.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *equipmentProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan defaults weight_unit and computes increment from the planned
+// equipment.
+func (r *equipmentProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the equipment profile is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan equipmentProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The weights are stored in weight_unit, so an unset weight_unit keeps
+	// the unit the profile was created with rather than following the
+	// provider. A weight_unit that is configured but not known yet stays
+	// unknown.
+	var configuredWeightUnit types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("weight_unit"), &configuredWeightUnit)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configuredWeightUnit.IsNull() {
+		plan.WeightUnit = types.StringValue(resolveWeightUnit(types.StringNull(), r.client))
+		if !req.State.Raw.IsNull() {
+			var state equipmentProfileResourceModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if !state.WeightUnit.IsNull() {
+				plan.WeightUnit = state.WeightUnit
+			}
+		}
+	}
+
+	if isFullyKnown(ctx, plan.Barbells) && isFullyKnown(ctx, plan.Plates) &&
+		isFullyKnown(ctx, plan.DumbbellSets) && isFullyKnown(ctx, plan.Machines) {
+		equipmentProfile, diags := plan.equipmentProfile(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.Increment = incrementValueOrNull(equipmentProfile)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// equipmentProfile converts the model into the API representation of the
+// equipment profile.
+func (m *equipmentProfileResourceModel) equipmentProfile(ctx context.Context) (*EquipmentProfile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	equipmentProfile := &EquipmentProfile{
+		Name:               m.Name.ValueString(),
+		WeightUnit:         m.WeightUnit.ValueString(),
+		OnUnloadableWeight: m.OnUnloadableWeight.ValueString(),
+	}
+
+	var barbells []barbellModel
+	diags.Append(m.Barbells.ElementsAs(ctx, &barbells, false)...)
+	for _, barbell := range barbells {
+		equipmentProfile.Barbells = append(equipmentProfile.Barbells, Barbell{
+			Name:   barbell.Name.ValueString(),
+			Weight: barbell.Weight.ValueFloat32(),
+		})
+	}
+
+	var plates []plateModel
+	diags.Append(m.Plates.ElementsAs(ctx, &plates, false)...)
+	for _, plate := range plates {
+		equipmentProfile.Plates = append(equipmentProfile.Plates, Plate{
+			Weight: plate.Weight.ValueFloat32(),
+			Pairs:  plate.Pairs.ValueInt32(),
+		})
+	}
+
+	equipmentProfile.DumbbellSets = weightRangesFromList(ctx, m.DumbbellSets, &diags)
+	equipmentProfile.Machines = weightRangesFromList(ctx, m.Machines, &diags)
+
+	return equipmentProfile, diags
+}
+
+// refresh maps an equipment profile returned by the API onto the model. Empty
+// lists are stored as null so that unset attributes do not produce a diff.
+func (m *equipmentProfileResourceModel) refresh(ctx context.Context, equipmentProfile *EquipmentProfile) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(strconv.Itoa(equipmentProfile.ID))
+	m.EquipmentProfileID = types.Int64Value(int64(equipmentProfile.ID))
+	m.Name = types.StringValue(equipmentProfile.Name)
+	m.WeightUnit = types.StringValue(equipmentProfile.WeightUnit)
+	m.OnUnloadableWeight = types.StringValue(equipmentProfile.OnUnloadableWeight)
+	m.Increment = incrementValueOrNull(equipmentProfile)
+	m.CreatedAt = timestampValueOrNull(equipmentProfile.CreatedAt)
+	m.UpdatedAt = timestampValueOrNull(equipmentProfile.UpdatedAt)
+	m.OwnerID = stringValueOrNull(equipmentProfile.OwnerID)
+
+	barbells := make([]barbellModel, 0, len(equipmentProfile.Barbells))
+	for _, barbell := range equipmentProfile.Barbells {
+		barbells = append(barbells, barbellModel{
+			Name:   types.StringValue(barbell.Name),
+			Weight: types.Float32Value(barbell.Weight),
+		})
+	}
+	m.Barbells = objectListValueOrNull(ctx, barbellAttrTypes, barbells, &diags)
+
+	plates := make([]plateModel, 0, len(equipmentProfile.Plates))
+	for _, plate := range equipmentProfile.Plates {
+		plates = append(plates, plateModel{
+			Weight: types.Float32Value(plate.Weight),
+			Pairs:  types.Int32Value(plate.Pairs),
+		})
+	}
+	m.Plates = objectListValueOrNull(ctx, plateAttrTypes, plates, &diags)
+
+	m.DumbbellSets = objectListValueOrNull(ctx, weightRangeAttrTypes, weightRangeModels(equipmentProfile.DumbbellSets), &diags)
+	m.Machines = objectListValueOrNull(ctx, weightRangeAttrTypes, weightRangeModels(equipmentProfile.Machines), &diags)
+
+	return diags
+}
+
+// incrementValueOrNull returns the increment of the equipment profile, or null
+// when it has no equipment that is loaded in steps.
+func incrementValueOrNull(equipmentProfile *EquipmentProfile) types.Float32 {
+	increment := equipmentIncrement(equipmentProfile)
+	if increment == 0 {
+		return types.Float32Null()
+	}
+	return types.Float32Value(increment)
+}
+
+func weightRangesFromList(ctx context.Context, list types.List, diags *diag.Diagnostics) []WeightRange {
+	var models []weightRangeModel
+	diags.Append(list.ElementsAs(ctx, &models, false)...)
+
+	var weightRanges []WeightRange
+	for _, model := range models {
+		weightRanges = append(weightRanges, WeightRange{
+			Name:      model.Name.ValueString(),
+			MinWeight: model.MinWeight.ValueFloat32(),
+			MaxWeight: model.MaxWeight.ValueFloat32(),
+			Increment: model.Increment.ValueFloat32(),
+		})
+	}
+	return weightRanges
+}
+
+func weightRangeModels(weightRanges []WeightRange) []weightRangeModel {
+	models := make([]weightRangeModel, 0, len(weightRanges))
+	for _, weightRange := range weightRanges {
+		models = append(models, weightRangeModel{
+			Name:      stringValueOrNull(weightRange.Name),
+			MinWeight: types.Float32Value(weightRange.MinWeight),
+			MaxWeight: types.Float32Value(weightRange.MaxWeight),
+			Increment: types.Float32Value(weightRange.Increment),
+		})
+	}
+	return models
+}
+
+// objectListValueOrNull returns a list of objects with the given attribute
+// types, or a null list when there are no elements.
+func objectListValueOrNull[T any](ctx context.Context, attrTypes map[string]attr.Type, elements []T, diags *diag.Diagnostics) types.List {
+	elementType := types.ObjectType{AttrTypes: attrTypes}
+	if len(elements) == 0 {
+		return types.ListNull(elementType)
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, elementType, elements)
+	diags.Append(listDiags...)
+	return list
+}
+
+// emptyIfNil returns an empty slice for nil, so that it is sent to the API as
+// an empty JSON array rather than null.
+func emptyIfNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Object = weightRangeValidator{}
+
+// weightRangeValidator checks that the min_weight of a dumbbell set or machine
+// is not above its max_weight.
+type weightRangeValidator struct{}
+
+func (v weightRangeValidator) Description(_ context.Context) string {
+	return "min_weight must be at most max_weight"
+}
+
+func (v weightRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v weightRangeValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	minWeight, minOk := req.ConfigValue.Attributes()["min_weight"].(types.Float32)
+	maxWeight, maxOk := req.ConfigValue.Attributes()["max_weight"].(types.Float32)
+	if !minOk || !maxOk || minWeight.IsNull() || minWeight.IsUnknown() || maxWeight.IsNull() || maxWeight.IsUnknown() {
+		return
+	}
+
+	if minWeight.ValueFloat32() > maxWeight.ValueFloat32() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("max_weight"),
+			"Invalid Weight Range",
+			fmt.Sprintf("max_weight (%g) must be at least min_weight (%g).", maxWeight.ValueFloat32(), minWeight.ValueFloat32()),
+		)
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &exerciseDataSource{}
+	_ datasource.DataSourceWithConfigure        = &exerciseDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &exerciseDataSource{}
+)
+
+func NewExerciseDataSource() datasource.DataSource {
+	return &exerciseDataSource{}
+}
+
+type exerciseDataSource struct {
+	client *BrickByBrickClient
+}
+
+type exerciseDataSourceModel struct {
+	ID                 types.Int64   `tfsdk:"id"`
+	Name               types.String  `tfsdk:"name"`
+	WeightUnit         types.String  `tfsdk:"weight_unit"`
+	DefaultWeight      types.Float32 `tfsdk:"default_weight"`
+	PrimaryMuscles     types.Set     `tfsdk:"primary_muscles"`
+	SecondaryMuscles   types.Set     `tfsdk:"secondary_muscles"`
+	Equipment          types.String  `tfsdk:"equipment"`
+	Category           types.String  `tfsdk:"category"`
+	MovementPattern    types.String  `tfsdk:"movement_pattern"`
+	Notes              types.String  `tfsdk:"notes"`
+	Archived           types.Bool    `tfsdk:"archived"`
+	CurrentWeight      types.Float32 `tfsdk:"current_weight"`
+	LastPerformedAt    types.String  `tfsdk:"last_performed_at"`
+	SessionsCompleted  types.Int32   `tfsdk:"sessions_completed"`
+	EstimatedOneRepMax types.Float32 `tfsdk:"estimated_one_rep_max"`
+	CreatedAt          types.String  `tfsdk:"created_at"`
+	UpdatedAt          types.String  `tfsdk:"updated_at"`
+	OwnerID            types.String  `tfsdk:"owner_id"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *exerciseDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *exerciseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exercise"
+}
+
+// Schema defines the schema for the data source.
+func (d *exerciseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single exercise by its numeric ID or its exact name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the exercise. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The exact name of the exercise, including case. Archived exercises are not matched by name. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"weight_unit": schema.StringAttribute{
+				Description: "The unit, lb or kg, that weights are returned in. Defaults to the provider weight_unit.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"default_weight": schema.Float32Attribute{
+				Description: "The starting weight for this exercise. Measured in weight_unit.",
+				Computed:    true,
+			},
+			"primary_muscles": schema.SetAttribute{
+				Description: "The muscle groups primarily trained by this exercise.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"secondary_muscles": schema.SetAttribute{
+				Description: "The muscle groups trained as a secondary effect of this exercise.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"equipment": schema.StringAttribute{
+				Description: "The equipment needed to perform the exercise.",
+				Computed:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "Whether the exercise is a compound or an isolation movement.",
+				Computed:    true,
+			},
+			"movement_pattern": schema.StringAttribute{
+				Description: "The movement pattern the exercise trains.",
+				Computed:    true,
+			},
+			"notes": schema.StringAttribute{
+				Description: "Free-text notes about the exercise.",
+				Computed:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Description: "Whether the exercise has been archived.",
+				Computed:    true,
+			},
+			"current_weight": schema.Float32Attribute{
+				Description: "The working weight the app has progressed this exercise to, in weight_unit.",
+				Computed:    true,
+			},
+			"last_performed_at": schema.StringAttribute{
+				Description: "When the exercise was last performed, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"sessions_completed": schema.Int32Attribute{
+				Description: "The number of sessions in which the exercise has been performed.",
+				Computed:    true,
+			},
+			"estimated_one_rep_max": schema.Float32Attribute{
+				Description: "The estimated one-rep max for the exercise, in weight_unit.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the exercise was created, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the exercise was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The identifier of the account that owns the exercise.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires the exercise to be looked up by exactly one of
+// id or name.
+func (d *exerciseDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *exerciseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state exerciseDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	weightUnit := resolveWeightUnit(state.WeightUnit, d.client)
+	weightPrecision := resolveWeightPrecision(types.Float64Null(), d.client)
+
+	var exercise *Exercise
+	if !state.ID.IsNull() {
+		var err error
+		exercise, err = d.client.GetExercise(strconv.FormatInt(state.ID.ValueInt64(), 10))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Unable to Read BrickByBrick Exercise",
+				"Could not read exercise ID "+state.ID.String()+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		exercise = d.findByName(state.Name.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(state.refresh(exercise, weightUnit, weightPrecision)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findByName returns the only unarchived exercise with exactly the given
+// name, adding an error when there is none or more than one.
+func (d *exerciseDataSource) findByName(name string, diags *diag.Diagnostics) *Exercise {
+	matches, err := d.client.FindExercisesByName(name)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read BrickByBrick Exercise",
+			"Could not list exercises to resolve name "+strconv.Quote(name)+": "+err.Error(),
+		)
+		return nil
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Exercise Not Found",
+			"No exercise is named "+strconv.Quote(name)+". Names are matched exactly, including case.",
+		)
+		return nil
+	case 1:
+		return &matches[0]
+	default:
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Ambiguous Exercise Name",
+			fmt.Sprintf("%d exercises are named %q (IDs %s). Look one of them up by its numeric id instead.",
+				len(matches), name, joinExerciseIDs(matches)),
+		)
+		return nil
+	}
+}
+
+// refresh maps an exercise returned by the API onto the data source model.
+func (m *exerciseDataSourceModel) refresh(exercise *Exercise, weightUnit string, weightPrecision float64) diag.Diagnostics {
+	var diags, setDiags diag.Diagnostics
+	m.ID = types.Int64Value(int64(exercise.ID))
+	m.Name = types.StringValue(exercise.Name)
+	m.DefaultWeight = types.Float32Value(fromPounds(exercise.DefaultWeight, weightUnit))
+	m.Equipment = stringValueOrNull(exercise.Equipment)
+	m.Category = stringValueOrNull(exercise.Category)
+	m.MovementPattern = stringValueOrNull(exercise.MovementPattern)
+	m.Notes = stringValueOrNull(exercise.Notes)
+	m.Archived = types.BoolValue(exercise.Archived)
+	m.CurrentWeight = weightPointerValue(exercise.CurrentWeight, weightUnit, weightPrecision)
+	m.LastPerformedAt = timestampValueOrNull(exercise.LastPerformedAt)
+	m.SessionsCompleted = types.Int32Value(exercise.SessionsCompleted)
+	m.EstimatedOneRepMax = weightPointerValue(exercise.EstimatedOneRepMax, weightUnit, weightPrecision)
+	m.CreatedAt = timestampValueOrNull(exercise.CreatedAt)
+	m.UpdatedAt = timestampValueOrNull(exercise.UpdatedAt)
+	m.OwnerID = stringValueOrNull(exercise.OwnerID)
+
+	m.PrimaryMuscles, setDiags = stringSetValueOrNull(exercise.PrimaryMuscles)
+	diags.Append(setDiags...)
+	m.SecondaryMuscles, setDiags = stringSetValueOrNull(exercise.SecondaryMuscles)
+	diags.Append(setDiags...)
+	return diags
+}
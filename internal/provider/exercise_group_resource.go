@@ -0,0 +1,507 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &exerciseGroupResource{}
+	_ resource.ResourceWithConfigure        = &exerciseGroupResource{}
+	_ resource.ResourceWithImportState      = &exerciseGroupResource{}
+	_ resource.ResourceWithConfigValidators = &exerciseGroupResource{}
+	_ resource.ResourceWithModifyPlan       = &exerciseGroupResource{}
+)
+
+const (
+	// exerciseGroupSuperset alternates between two exercises.
+	exerciseGroupSuperset = "superset"
+
+	// exerciseGroupGiantSet performs three or more exercises back to back.
+	exerciseGroupGiantSet = "giant_set"
+
+	// exerciseGroupCircuit cycles through a series of exercises for a number
+	// of rounds.
+	exerciseGroupCircuit = "circuit"
+)
+
+// exerciseGroupTypes are the values accepted by type.
+var exerciseGroupTypes = []string{exerciseGroupSuperset, exerciseGroupGiantSet, exerciseGroupCircuit}
+
+// NewExerciseGroupResource is a helper function to simplify the provider implementation.
+func NewExerciseGroupResource() resource.Resource {
+	return &exerciseGroupResource{}
+}
+
+// exerciseGroupResource is the resource implementation.
+type exerciseGroupResource struct {
+	client *BrickByBrickClient
+}
+
+type exerciseGroupResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	ExerciseGroupID             types.Int64  `tfsdk:"exercise_group_id"`
+	Name                        types.String `tfsdk:"name"`
+	WorkoutTemplateID           types.Int64  `tfsdk:"workout_template_id"`
+	Type                        types.String `tfsdk:"type"`
+	ExerciseIDs                 types.List   `tfsdk:"exercise_ids"`
+	Rounds                      types.Int32  `tfsdk:"rounds"`
+	RestBetweenExercisesSeconds types.Int32  `tfsdk:"rest_between_exercises_seconds"`
+	RestBetweenRoundsSeconds    types.Int32  `tfsdk:"rest_between_rounds_seconds"`
+	CreatedAt                   types.String `tfsdk:"created_at"`
+	UpdatedAt                   types.String `tfsdk:"updated_at"`
+	OwnerID                     types.String `tfsdk:"owner_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *exerciseGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exercise_group"
+}
+
+// Create a new resource.
+func (r *exerciseGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan exerciseGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	newExerciseGroup := ExerciseGroup{
+		Name:                        plan.Name.ValueString(),
+		WorkoutTemplateID:           int(plan.WorkoutTemplateID.ValueInt64()),
+		Type:                        plan.Type.ValueString(),
+		Rounds:                      plan.Rounds.ValueInt32(),
+		RestBetweenExercisesSeconds: plan.RestBetweenExercisesSeconds.ValueInt32(),
+		RestBetweenRoundsSeconds:    plan.RestBetweenRoundsSeconds.ValueInt32(),
+	}
+	newExerciseGroup.ExerciseIDs, diags = intsFromList(ctx, plan.ExerciseIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdExerciseGroup, err := r.client.CreateExerciseGroup(newExerciseGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating exercise group",
+			"Could not create exercise group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(plan.refresh(ctx, createdExerciseGroup)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *exerciseGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state exerciseGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedExerciseGroup, err := r.client.GetExerciseGroup(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading BrickByBrick Exercise Group",
+			"Could not read BrickByBrick exercise group ID "+state.ID.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(state.refresh(ctx, refreshedExerciseGroup)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *exerciseGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan exerciseGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state exerciseGroupResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from the attributes that changed
+	changes := map[string]any{}
+	if !plan.Name.Equal(state.Name) {
+		changes["name"] = plan.Name.ValueString()
+	}
+	if !plan.WorkoutTemplateID.Equal(state.WorkoutTemplateID) {
+		changes["workout_template_id"] = plan.WorkoutTemplateID.ValueInt64()
+	}
+	if !plan.Type.Equal(state.Type) {
+		changes["type"] = plan.Type.ValueString()
+	}
+	if !plan.ExerciseIDs.Equal(state.ExerciseIDs) {
+		exerciseIDs, diags := intsFromList(ctx, plan.ExerciseIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		changes["exercise_ids"] = exerciseIDs
+	}
+	if !plan.Rounds.Equal(state.Rounds) {
+		changes["rounds"] = plan.Rounds.ValueInt32()
+	}
+	if !plan.RestBetweenExercisesSeconds.Equal(state.RestBetweenExercisesSeconds) {
+		changes["rest_between_exercises_seconds"] = plan.RestBetweenExercisesSeconds.ValueInt32()
+	}
+	if !plan.RestBetweenRoundsSeconds.Equal(state.RestBetweenRoundsSeconds) {
+		changes["rest_between_rounds_seconds"] = plan.RestBetweenRoundsSeconds.ValueInt32()
+	}
+
+	var exerciseGroup *ExerciseGroup
+	if len(changes) > 0 {
+		var err error
+		exerciseGroup, err = r.client.PatchExerciseGroup(plan.ID.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating exercise group",
+				"Could not update exercise group, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Only fetch the exercise group when nothing was sent or the PATCH
+	// response did not include it.
+	if exerciseGroup == nil {
+		var err error
+		exerciseGroup, err = r.client.GetExerciseGroup(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading exercise group",
+				"Could not read exercise group ID "+plan.ID.String()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(plan.refresh(ctx, exerciseGroup)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *exerciseGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state exerciseGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteExerciseGroup(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting BrickByBrick Exercise Group",
+			"Could not delete exercise group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *exerciseGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *BrickByBrickClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *exerciseGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Groups exercises of a workout template into a superset, giant set or circuit that is performed back to back.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the exercise group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"exercise_group_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The unique identifier for the exercise group as a number.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the exercise group.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"workout_template_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The workout_template_id of the workout template the group belongs to. Every exercise in the group must be in the workout template.",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The kind of group: superset (exactly 2 exercises), giant_set (3 or more) or circuit.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(exerciseGroupTypes...),
+				},
+			},
+			"exercise_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.Int64Type,
+				Description: "The exercise_id of each exercise in the group, in the order they are performed.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(2),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"rounds": schema.Int32Attribute{
+				Required:    true,
+				Description: "How many times the whole group is performed.",
+				Validators: []validator.Int32{
+					int32validator.Between(1, 100),
+				},
+			},
+			"rest_between_exercises_seconds": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(0),
+				Description: "The rest after each exercise within a round, in seconds. Defaults to 0.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"rest_between_rounds_seconds": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(0),
+				Description: "The rest after each round, in seconds. Defaults to 0.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the exercise group was created, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the exercise group was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The identifier of the account that owns the exercise group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *exerciseGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ConfigValidators returns the validators that check combinations of
+// attributes.
+func (r *exerciseGroupResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		exerciseGroupSizeValidator{},
+	}
+}
+
+// ModifyPlan checks that every exercise in the group is in its workout
+// template.
+func (r *exerciseGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the exercise group is being destroyed, or before
+	// the provider has been configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan exerciseGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A workout template created in the same apply cannot be looked up yet.
+	if plan.WorkoutTemplateID.IsUnknown() || !isFullyKnown(ctx, plan.ExerciseIDs) {
+		return
+	}
+
+	// Only check when the relevant attributes change, so that the workout
+	// template is not fetched on every plan.
+	if !req.State.Raw.IsNull() {
+		var state exerciseGroupResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.WorkoutTemplateID.Equal(state.WorkoutTemplateID) && plan.ExerciseIDs.Equal(state.ExerciseIDs) {
+			return
+		}
+	}
+
+	workoutTemplate, err := r.client.GetWorkoutTemplate(strconv.FormatInt(plan.WorkoutTemplateID.ValueInt64(), 10))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workout_template_id"),
+			"Unable to Read BrickByBrick Workout Template",
+			"Could not read workout template ID "+plan.WorkoutTemplateID.String()+" to check the exercises: "+err.Error(),
+		)
+		return
+	}
+
+	exerciseIDs, diags := intsFromList(ctx, plan.ExerciseIDs)
+	resp.Diagnostics.Append(diags...)
+	for _, i := range exercisesNotInWorkoutTemplate(workoutTemplate, exerciseIDs) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("exercise_ids").AtListIndex(i),
+			"Exercise Not In Workout Template",
+			fmt.Sprintf("Exercise ID %d is not in workout template %q (ID %d). Add it to the workout template's exercises first.",
+				exerciseIDs[i], workoutTemplate.Name, workoutTemplate.ID),
+		)
+	}
+}
+
+// exercisesNotInWorkoutTemplate returns the index of each exercise ID that is
+// not one of the workout template's exercises.
+func exercisesNotInWorkoutTemplate(workoutTemplate *WorkoutTemplate, exerciseIDs []int) []int {
+	inTemplate := map[int]bool{}
+	for _, exercise := range workoutTemplate.Exercises {
+		inTemplate[exercise.ExerciseID] = true
+	}
+
+	var missing []int
+	for i, exerciseID := range exerciseIDs {
+		if !inTemplate[exerciseID] {
+			missing = append(missing, i)
+		}
+	}
+	return missing
+}
+
+// refresh maps an exercise group returned by the API onto the model.
+func (m *exerciseGroupResourceModel) refresh(ctx context.Context, exerciseGroup *ExerciseGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(strconv.Itoa(exerciseGroup.ID))
+	m.ExerciseGroupID = types.Int64Value(int64(exerciseGroup.ID))
+	m.Name = types.StringValue(exerciseGroup.Name)
+	m.WorkoutTemplateID = types.Int64Value(int64(exerciseGroup.WorkoutTemplateID))
+	m.Type = types.StringValue(exerciseGroup.Type)
+	m.Rounds = types.Int32Value(exerciseGroup.Rounds)
+	m.RestBetweenExercisesSeconds = types.Int32Value(exerciseGroup.RestBetweenExercisesSeconds)
+	m.RestBetweenRoundsSeconds = types.Int32Value(exerciseGroup.RestBetweenRoundsSeconds)
+	m.CreatedAt = timestampValueOrNull(exerciseGroup.CreatedAt)
+	m.UpdatedAt = timestampValueOrNull(exerciseGroup.UpdatedAt)
+	m.OwnerID = stringValueOrNull(exerciseGroup.OwnerID)
+
+	m.ExerciseIDs, diags = intListValue(ctx, exerciseGroup.ExerciseIDs)
+	return diags
+}
+
+// intsFromList converts a list of Int64 into the IDs sent to the API.
+func intsFromList(ctx context.Context, list types.List) ([]int, diag.Diagnostics) {
+	var values []int64
+	diags := list.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ints := make([]int, 0, len(values))
+	for _, value := range values {
+		ints = append(ints, int(value))
+	}
+	return ints, diags
+}
+
+// intListValue converts IDs returned by the API into a list of Int64.
+func intListValue(ctx context.Context, ints []int) (types.List, diag.Diagnostics) {
+	values := make([]int64, 0, len(ints))
+	for _, value := range ints {
+		values = append(values, int64(value))
+	}
+	return types.ListValueFrom(ctx, types.Int64Type, values)
+}
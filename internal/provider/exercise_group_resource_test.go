@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestExercisesNotInWorkoutTemplate(t *testing.T) {
+	workoutTemplate := &WorkoutTemplate{
+		ID:   3,
+		Name: "Day A",
+		Exercises: []WorkoutTemplateExercise{
+			{ExerciseID: 1},
+			{ExerciseID: 2},
+			{ExerciseID: 4},
+		},
+	}
+
+	testCases := map[string]struct {
+		exerciseIDs []int
+		want        []int
+	}{
+		"all-in-template": {
+			exerciseIDs: []int{4, 1},
+			want:        nil,
+		},
+		"one-missing": {
+			exerciseIDs: []int{1, 3, 2},
+			want:        []int{1},
+		},
+		"all-missing": {
+			exerciseIDs: []int{5, 6},
+			want:        []int{0, 1},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := exercisesNotInWorkoutTemplate(workoutTemplate, testCase.exerciseIDs)
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("exercisesNotInWorkoutTemplate(%v) = %v, want %v", testCase.exerciseIDs, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestIntListRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	want := []int{3, 1, 2}
+
+	list, diags := intListValue(ctx, want)
+	if diags.HasError() {
+		t.Fatalf("unexpected error building list: %v", diags)
+	}
+	got, diags := intsFromList(ctx, list)
+	if diags.HasError() {
+		t.Fatalf("unexpected error reading list: %v", diags)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = exerciseGroupSizeValidator{}
+
+// exerciseGroupSizeValidator checks that the number of exercise_ids suits the
+// type of group: exactly two for a superset, and at least three for a giant
+// set. A circuit needs at least two, which the schema already requires.
+type exerciseGroupSizeValidator struct{}
+
+func (v exerciseGroupSizeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("a %s must have exactly 2 exercises and a %s at least 3", exerciseGroupSuperset, exerciseGroupGiantSet)
+}
+
+func (v exerciseGroupSizeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v exerciseGroupSizeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var groupType types.String
+	var exerciseIDs types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &groupType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("exercise_ids"), &exerciseIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values that are not known until apply are checked once they are.
+	if groupType.IsNull() || groupType.IsUnknown() || exerciseIDs.IsNull() || exerciseIDs.IsUnknown() {
+		return
+	}
+
+	count := len(exerciseIDs.Elements())
+	switch {
+	case groupType.ValueString() == exerciseGroupSuperset && count != 2:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("exercise_ids"),
+			"Wrong Number of Exercises",
+			fmt.Sprintf("A %s pairs exactly 2 exercises, but %d are listed. Use a %s or %s for more.",
+				exerciseGroupSuperset, count, exerciseGroupGiantSet, exerciseGroupCircuit),
+		)
+	case groupType.ValueString() == exerciseGroupGiantSet && count < 3:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("exercise_ids"),
+			"Wrong Number of Exercises",
+			fmt.Sprintf("A %s has at least 3 exercises, but %d are listed. Use a %s to pair 2 exercises.",
+				exerciseGroupGiantSet, count, exerciseGroupSuperset),
+		)
+	}
+}
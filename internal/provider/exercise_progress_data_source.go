@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &exerciseProgressDataSource{}
+	_ datasource.DataSourceWithConfigure = &exerciseProgressDataSource{}
+)
+
+func NewExerciseProgressDataSource() datasource.DataSource {
+	return &exerciseProgressDataSource{}
+}
+
+type exerciseProgressDataSource struct {
+	client *BrickByBrickClient
+}
+
+type exerciseProgressDataSourceModel struct {
+	ExerciseID     types.Int64                    `tfsdk:"exercise_id"`
+	WeightUnit     types.String                   `tfsdk:"weight_unit"`
+	StartingWeight types.Float32                  `tfsdk:"starting_weight"`
+	CurrentWeight  types.Float32                  `tfsdk:"current_weight"`
+	TotalSessions  types.Int32                    `tfsdk:"total_sessions"`
+	BestSet        *exerciseProgressSetModel      `tfsdk:"best_set"`
+	History        []exerciseProgressHistoryModel `tfsdk:"history"`
+}
+
+type exerciseProgressSetModel struct {
+	SessionID types.Int64   `tfsdk:"session_id"`
+	Date      types.String  `tfsdk:"date"`
+	Weight    types.Float32 `tfsdk:"weight"`
+	Reps      types.Int32   `tfsdk:"reps"`
+}
+
+type exerciseProgressHistoryModel struct {
+	SessionID     types.Int64   `tfsdk:"session_id"`
+	Date          types.String  `tfsdk:"date"`
+	WorkingWeight types.Float32 `tfsdk:"working_weight"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *exerciseProgressDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *exerciseProgressDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exercise_progress"
+}
+
+// Schema defines the schema for the data source.
+func (d *exerciseProgressDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Summarises the progress of an exercise across every logged workout session.",
+		Attributes: map[string]schema.Attribute{
+			"exercise_id": schema.Int64Attribute{
+				Description: "The exercise_id of the exercise.",
+				Required:    true,
+			},
+			"weight_unit": schema.StringAttribute{
+				Description: "The unit, lb or kg, that weights are returned in. Defaults to the provider weight_unit.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"starting_weight": schema.Float32Attribute{
+				Description: "The working weight of the first session the exercise was performed in, or its default weight when it has never been performed.",
+				Computed:    true,
+			},
+			"current_weight": schema.Float32Attribute{
+				Description: "The working weight the app has progressed the exercise to, or its default weight when it has never been performed.",
+				Computed:    true,
+			},
+			"total_sessions": schema.Int32Attribute{
+				Description: "The number of sessions the exercise was performed in.",
+				Computed:    true,
+			},
+			"best_set": schema.SingleNestedAttribute{
+				Description: "The heaviest completed set, with the most reps breaking ties and the earliest winning a full tie, or null when no set has been completed.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"session_id": schema.Int64Attribute{
+						Description: "The id of the session the set was performed in.",
+						Computed:    true,
+					},
+					"date": schema.StringAttribute{
+						Description: "The date the set was performed, in YYYY-MM-DD format.",
+						Computed:    true,
+					},
+					"weight": schema.Float32Attribute{
+						Description: "The weight of the set, in weight_unit.",
+						Computed:    true,
+					},
+					"reps": schema.Int32Attribute{
+						Description: "The number of reps performed.",
+						Computed:    true,
+					},
+				},
+			},
+			"history": schema.ListNestedAttribute{
+				Description: "The working weight of each session the exercise was performed in, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"session_id": schema.Int64Attribute{
+							Description: "The id of the session.",
+							Computed:    true,
+						},
+						"date": schema.StringAttribute{
+							Description: "The date of the session, in YYYY-MM-DD format.",
+							Computed:    true,
+						},
+						"working_weight": schema.Float32Attribute{
+							Description: "The heaviest weight of any set of the exercise in the session, in weight_unit.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *exerciseProgressDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state exerciseProgressDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	weightUnit := resolveWeightUnit(state.WeightUnit, d.client)
+	weightPrecision := resolveWeightPrecision(types.Float64Null(), d.client)
+
+	exercise, err := d.client.GetExercise(strconv.FormatInt(state.ExerciseID.ValueInt64(), 10))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("exercise_id"),
+			"Unable to Read BrickByBrick Exercise",
+			"Could not read exercise ID "+state.ExerciseID.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	sessions, err := d.client.ListAllWorkoutSessions(WorkoutSessionListOptions{
+		ExerciseIDs: []int{exercise.ID},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read BrickByBrick Workout Sessions",
+			"Could not list the workout sessions of exercise ID "+state.ExerciseID.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	progress := exerciseProgressFromSessions(sessions, exercise.ID)
+
+	currentWeight := exercise.DefaultWeight
+	if exercise.CurrentWeight != nil {
+		currentWeight = *exercise.CurrentWeight
+	}
+	startingWeight := exercise.DefaultWeight
+	if len(progress.History) > 0 {
+		startingWeight = progress.History[0].WorkingWeight
+	}
+
+	// Map response body to model
+	state.StartingWeight = weightPointerValue(&startingWeight, weightUnit, weightPrecision)
+	state.CurrentWeight = weightPointerValue(&currentWeight, weightUnit, weightPrecision)
+	state.TotalSessions = types.Int32Value(int32(len(progress.History)))
+	state.BestSet = nil
+	if progress.BestSet != nil {
+		state.BestSet = &exerciseProgressSetModel{
+			SessionID: types.Int64Value(int64(progress.BestSet.SessionID)),
+			Date:      types.StringValue(progress.BestSet.Date),
+			Weight:    weightPointerValue(&progress.BestSet.Weight, weightUnit, weightPrecision),
+			Reps:      types.Int32Value(progress.BestSet.Reps),
+		}
+	}
+	state.History = []exerciseProgressHistoryModel{}
+	for _, point := range progress.History {
+		state.History = append(state.History, exerciseProgressHistoryModel{
+			SessionID:     types.Int64Value(int64(point.SessionID)),
+			Date:          types.StringValue(point.Date),
+			WorkingWeight: weightPointerValue(&point.WorkingWeight, weightUnit, weightPrecision),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// exerciseProgress is the progress of one exercise, with weights in lbs.
+type exerciseProgress struct {
+	History []exerciseProgressPoint
+	BestSet *exerciseProgressSet
+}
+
+type exerciseProgressPoint struct {
+	SessionID     int
+	Date          string
+	WorkingWeight float32
+}
+
+type exerciseProgressSet struct {
+	SessionID int
+	Date      string
+	Weight    float32
+	Reps      int32
+}
+
+// exerciseProgressFromSessions summarises the sets of exerciseID across
+// sessions, which must be oldest first. Sessions without a set of the
+// exercise are skipped.
+func exerciseProgressFromSessions(sessions []WorkoutSession, exerciseID int) exerciseProgress {
+	var progress exerciseProgress
+	for _, session := range sessions {
+		var sets []WorkoutSet
+		for _, exercise := range session.Exercises {
+			if exercise.ExerciseID == exerciseID {
+				sets = append(sets, exercise.Sets...)
+			}
+		}
+		if len(sets) == 0 {
+			continue
+		}
+
+		point := exerciseProgressPoint{SessionID: session.ID, Date: session.Date}
+		for _, set := range sets {
+			point.WorkingWeight = max(point.WorkingWeight, set.Weight)
+
+			if !set.Completed {
+				continue
+			}
+			best := progress.BestSet
+			if best == nil || set.Weight > best.Weight || (set.Weight == best.Weight && set.Reps > best.Reps) {
+				progress.BestSet = &exerciseProgressSet{
+					SessionID: session.ID,
+					Date:      session.Date,
+					Weight:    set.Weight,
+					Reps:      set.Reps,
+				}
+			}
+		}
+		progress.History = append(progress.History, point)
+	}
+	return progress
+}
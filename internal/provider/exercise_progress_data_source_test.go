@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExerciseProgressFromSessions(t *testing.T) {
+	squat := func(sets ...WorkoutSet) WorkoutSessionExercise {
+		return WorkoutSessionExercise{ExerciseID: 1, Sets: sets}
+	}
+	bench := WorkoutSessionExercise{ExerciseID: 2, Sets: []WorkoutSet{{Weight: 500, Reps: 5, Completed: true}}}
+
+	testCases := map[string]struct {
+		sessions    []WorkoutSession
+		wantHistory []exerciseProgressPoint
+		wantBestSet *exerciseProgressSet
+	}{
+		"no-sessions": {},
+		"other-exercises-only": {
+			sessions: []WorkoutSession{{ID: 1, Date: "2026-01-01", Exercises: []WorkoutSessionExercise{bench}}},
+		},
+		"working-weight-is-heaviest-set": {
+			sessions: []WorkoutSession{
+				{ID: 1, Date: "2026-01-01", Exercises: []WorkoutSessionExercise{
+					squat(WorkoutSet{Weight: 135, Reps: 5, Completed: true}, WorkoutSet{Weight: 185, Reps: 5, Completed: true}),
+					bench,
+				}},
+				{ID: 2, Date: "2026-01-03", Exercises: []WorkoutSessionExercise{bench}},
+				{ID: 3, Date: "2026-01-05", Exercises: []WorkoutSessionExercise{
+					squat(WorkoutSet{Weight: 190, Reps: 5, Completed: true}),
+				}},
+			},
+			wantHistory: []exerciseProgressPoint{
+				{SessionID: 1, Date: "2026-01-01", WorkingWeight: 185},
+				{SessionID: 3, Date: "2026-01-05", WorkingWeight: 190},
+			},
+			wantBestSet: &exerciseProgressSet{SessionID: 3, Date: "2026-01-05", Weight: 190, Reps: 5},
+		},
+		"failed-sets-are-not-best": {
+			sessions: []WorkoutSession{
+				{ID: 1, Date: "2026-01-01", Exercises: []WorkoutSessionExercise{
+					squat(WorkoutSet{Weight: 200, Reps: 5, Completed: true}, WorkoutSet{Weight: 210, Reps: 3}),
+				}},
+			},
+			wantHistory: []exerciseProgressPoint{{SessionID: 1, Date: "2026-01-01", WorkingWeight: 210}},
+			wantBestSet: &exerciseProgressSet{SessionID: 1, Date: "2026-01-01", Weight: 200, Reps: 5},
+		},
+		"more-reps-break-ties-and-earliest-wins": {
+			sessions: []WorkoutSession{
+				{ID: 1, Date: "2026-01-01", Exercises: []WorkoutSessionExercise{
+					squat(WorkoutSet{Weight: 200, Reps: 3, Completed: true}),
+				}},
+				{ID: 2, Date: "2026-01-03", Exercises: []WorkoutSessionExercise{
+					squat(WorkoutSet{Weight: 200, Reps: 5, Completed: true}),
+				}},
+				{ID: 3, Date: "2026-01-05", Exercises: []WorkoutSessionExercise{
+					squat(WorkoutSet{Weight: 200, Reps: 5, Completed: true}),
+				}},
+			},
+			wantHistory: []exerciseProgressPoint{
+				{SessionID: 1, Date: "2026-01-01", WorkingWeight: 200},
+				{SessionID: 2, Date: "2026-01-03", WorkingWeight: 200},
+				{SessionID: 3, Date: "2026-01-05", WorkingWeight: 200},
+			},
+			wantBestSet: &exerciseProgressSet{SessionID: 2, Date: "2026-01-03", Weight: 200, Reps: 5},
+		},
+		"no-completed-sets": {
+			sessions: []WorkoutSession{
+				{ID: 1, Date: "2026-01-01", Exercises: []WorkoutSessionExercise{
+					squat(WorkoutSet{Weight: 200, Reps: 2}),
+				}},
+			},
+			wantHistory: []exerciseProgressPoint{{SessionID: 1, Date: "2026-01-01", WorkingWeight: 200}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := exerciseProgressFromSessions(testCase.sessions, 1)
+			if !slices.Equal(got.History, testCase.wantHistory) {
+				t.Errorf("got history %v, want %v", got.History, testCase.wantHistory)
+			}
+			if (got.BestSet == nil) != (testCase.wantBestSet == nil) ||
+				(got.BestSet != nil && *got.BestSet != *testCase.wantBestSet) {
+				t.Errorf("got best set %+v, want %+v", got.BestSet, testCase.wantBestSet)
+			}
+		})
+	}
+}
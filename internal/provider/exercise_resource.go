@@ -7,24 +7,36 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &exerciseResource{}
-	_ resource.ResourceWithConfigure   = &exerciseResource{}
-	_ resource.ResourceWithImportState = &exerciseResource{}
+	_ resource.Resource                 = &exerciseResource{}
+	_ resource.ResourceWithConfigure    = &exerciseResource{}
+	_ resource.ResourceWithImportState  = &exerciseResource{}
+	_ resource.ResourceWithUpgradeState = &exerciseResource{}
+	_ resource.ResourceWithModifyPlan   = &exerciseResource{}
 )
 
 // NewExerciseResource is a helper function to simplify the provider implementation.
@@ -38,9 +50,103 @@ type exerciseResource struct {
 }
 
 type exerciseResourceModel struct {
-	ID            types.String  `tfsdk:"id"`
-	Name          types.String  `tfsdk:"name"`
-	DefaultWeight types.Float32 `tfsdk:"default_weight"`
+	ID                 types.String  `tfsdk:"id"`
+	ExerciseID         types.Int64   `tfsdk:"exercise_id"`
+	Name               types.String  `tfsdk:"name"`
+	DefaultWeight      WeightValue   `tfsdk:"default_weight"`
+	PrimaryMuscles     types.Set     `tfsdk:"primary_muscles"`
+	SecondaryMuscles   types.Set     `tfsdk:"secondary_muscles"`
+	Equipment          types.String  `tfsdk:"equipment"`
+	Category           types.String  `tfsdk:"category"`
+	MovementPattern    types.String  `tfsdk:"movement_pattern"`
+	Notes              types.String  `tfsdk:"notes"`
+	WeightUnit         types.String  `tfsdk:"weight_unit"`
+	WeightPrecision    types.Float64 `tfsdk:"weight_precision"`
+	EquipmentProfileID types.Int64   `tfsdk:"equipment_profile_id"`
+	OnDestroy          types.String  `tfsdk:"on_destroy"`
+	DeletionProtection types.Bool    `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool    `tfsdk:"adopt_existing"`
+
+	LoadableDefaultWeight types.Float32 `tfsdk:"loadable_default_weight"`
+
+	CurrentWeight      types.Float32 `tfsdk:"current_weight"`
+	LastPerformedAt    types.String  `tfsdk:"last_performed_at"`
+	SessionsCompleted  types.Int32   `tfsdk:"sessions_completed"`
+	EstimatedOneRepMax types.Float32 `tfsdk:"estimated_one_rep_max"`
+
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+	OwnerID   types.String `tfsdk:"owner_id"`
+}
+
+// exerciseDeletionProtectionDefault is the deletion_protection of an exercise
+// when neither the resource nor the provider sets it. Deleting an exercise
+// deletes its logged history, so exercises are protected by default.
+const exerciseDeletionProtectionDefault = true
+
+// exerciseImportNamePrefix marks an import ID that identifies an exercise by
+// name rather than by numeric ID.
+const exerciseImportNamePrefix = "name:"
+
+// exerciseMuscleGroups are the muscle groups accepted by primary_muscles and
+// secondary_muscles.
+var exerciseMuscleGroups = []string{
+	"abductors",
+	"abs",
+	"adductors",
+	"biceps",
+	"calves",
+	"chest",
+	"forearms",
+	"front_delts",
+	"glutes",
+	"hamstrings",
+	"lats",
+	"lower_back",
+	"neck",
+	"obliques",
+	"quads",
+	"rear_delts",
+	"side_delts",
+	"traps",
+	"triceps",
+	"upper_back",
+}
+
+// exerciseEquipment are the values accepted by equipment.
+var exerciseEquipment = []string{
+	"band",
+	"barbell",
+	"bodyweight",
+	"cable",
+	"dumbbell",
+	"ez_bar",
+	"kettlebell",
+	"machine",
+	"smith_machine",
+	"trap_bar",
+	"other",
+}
+
+// exerciseCategories are the values accepted by category.
+var exerciseCategories = []string{
+	"compound",
+	"isolation",
+}
+
+// exerciseMovementPatterns are the values accepted by movement_pattern.
+var exerciseMovementPatterns = []string{
+	"horizontal_push",
+	"vertical_push",
+	"horizontal_pull",
+	"vertical_pull",
+	"squat",
+	"hinge",
+	"lunge",
+	"carry",
+	"rotation",
+	"anti_rotation",
+	"other",
 }
 
 // Metadata returns the resource type name.
@@ -59,26 +165,51 @@ func (r *exerciseResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Generate API request body from plan
+	weightUnit := resolveWeightUnit(plan.WeightUnit, r.client)
+	weightPrecision := resolveWeightPrecision(plan.WeightPrecision, r.client)
 
 	newExercise := Exercise{
-		Name:          plan.Name.ValueString(),
-		DefaultWeight: *plan.DefaultWeight.ValueFloat32Pointer(),
+		Name:               plan.Name.ValueString(),
+		DefaultWeight:      toPounds(plan.loadedDefaultWeight().ValueFloat32(), weightUnit),
+		Equipment:          plan.Equipment.ValueString(),
+		Category:           plan.Category.ValueString(),
+		MovementPattern:    plan.MovementPattern.ValueString(),
+		Notes:              plan.Notes.ValueString(),
+		EquipmentProfileID: intPointerValue(plan.EquipmentProfileID),
+	}
+	resp.Diagnostics.Append(plan.PrimaryMuscles.ElementsAs(ctx, &newExercise.PrimaryMuscles, false)...)
+	resp.Diagnostics.Append(plan.SecondaryMuscles.ElementsAs(ctx, &newExercise.SecondaryMuscles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createdExercise *Exercise
+	if resolveAdoptExisting(plan.AdoptExisting, r.client) {
+		createdExercise = r.adopt(ctx, newExercise, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Create new order
-	createdExercise, err := r.client.CreateExercise(newExercise)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating exercise",
-			"Could not create exercise, unexpected error: "+err.Error(),
-		)
-		return
+	if createdExercise == nil {
+		var err error
+		createdExercise, err = r.client.CreateExercise(newExercise)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating exercise",
+				"Could not create exercise, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan.ID = types.StringValue(strconv.Itoa(createdExercise.ID))
-	plan.DefaultWeight = types.Float32Value(createdExercise.DefaultWeight)
-	plan.Name = types.StringValue(createdExercise.Name)
+	resp.Diagnostics.Append(plan.refresh(createdExercise, weightUnit, weightPrecision)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.refreshProgress(createdExercise, weightUnit, weightPrecision)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -88,6 +219,56 @@ func (r *exerciseResource) Create(ctx context.Context, req resource.CreateReques
 	}
 }
 
+// adopt takes over the existing exercise with the same name as exercise and
+// updates it to match the plan. It returns nil when no such exercise exists.
+func (r *exerciseResource) adopt(ctx context.Context, exercise Exercise, diags *diag.Diagnostics) *Exercise {
+	matches, err := r.client.FindExercisesByName(exercise.Name)
+	if err != nil {
+		diags.AddError(
+			"Error creating exercise",
+			"Could not look up existing exercises to adopt, unexpected error: "+err.Error(),
+		)
+		return nil
+	}
+
+	if len(matches) == 0 {
+		return nil
+	}
+	if len(matches) > 1 {
+		diags.AddAttributeError(
+			path.Root("adopt_existing"),
+			"Ambiguous Exercise To Adopt",
+			fmt.Sprintf("%d exercises are named %q (IDs %s), so none of them was adopted. "+
+				"Import the intended exercise by its numeric ID instead.",
+				len(matches), exercise.Name, joinExerciseIDs(matches)),
+		)
+		return nil
+	}
+
+	exerciseID := strconv.Itoa(matches[0].ID)
+	tflog.Info(ctx, "Adopting existing BrickByBrick exercise", map[string]any{"id": exerciseID})
+
+	_, err = r.client.UpdateExercise(exerciseID, exercise)
+	if err != nil {
+		diags.AddError(
+			"Error adopting exercise",
+			"Could not update existing exercise ID "+exerciseID+": "+err.Error(),
+		)
+		return nil
+	}
+
+	adopted, err := r.client.GetExercise(exerciseID)
+	if err != nil {
+		diags.AddError(
+			"Error adopting exercise",
+			"Could not read exercise ID "+exerciseID+": "+err.Error(),
+		)
+		return nil
+	}
+
+	return adopted
+}
+
 // Read refreshes the Terraform state with the latest data.
 // Read resource information.
 func (r *exerciseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -109,9 +290,33 @@ func (r *exerciseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	// Overwrite items with refreshed state
-	state.Name = types.StringValue(refreshedExercise.Name)
-	state.DefaultWeight = types.Float32Value(refreshedExercise.DefaultWeight)
+	// Overwrite configured items with refreshed state. default_weight is
+	// only ever the configured starting weight; the progression the app
+	// applies after each session is tracked separately in the read-only
+	// progress attributes. When the API reflects that progression in
+	// default_weight, the configured value is kept so that it does not show
+	// up as drift.
+	weightUnit := resolveWeightUnit(state.WeightUnit, r.client)
+	weightPrecision := resolveWeightPrecision(state.WeightPrecision, r.client)
+	configuredDefaultWeight, configuredLoadableDefaultWeight := state.DefaultWeight, state.LoadableDefaultWeight
+	resp.Diagnostics.Append(state.refresh(refreshedExercise, weightUnit, weightPrecision)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configuredDefaultWeight.IsNull() && !configuredLoadableDefaultWeight.IsNull() && defaultWeightReflectsProgression(refreshedExercise) {
+		state.DefaultWeight = configuredDefaultWeight
+		state.LoadableDefaultWeight = configuredLoadableDefaultWeight
+	}
+	state.refreshProgress(refreshedExercise, weightUnit, weightPrecision)
+
+	// deletion_protection and on_destroy are not stored by the API, so
+	// imported or upgraded state starts from the defaults.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(resolveDeletionProtection(r.client, exerciseDeletionProtectionDefault))
+	}
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue(onDestroyDelete)
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -131,35 +336,50 @@ func (r *exerciseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// Generate API request body from plan
-	updatedExercise := Exercise{
-		Name:          plan.Name.ValueString(),
-		DefaultWeight: plan.DefaultWeight.ValueFloat32(),
+	var state exerciseResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Update existing order
-	_, err := r.client.UpdateExercise(plan.ID.ValueString(), updatedExercise)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating exercise",
-			"Could not update exercise, unexpected error: "+err.Error(),
-		)
-		return
+	// Generate API request body from the attributes that changed
+	weightUnit := resolveWeightUnit(plan.WeightUnit, r.client)
+	weightPrecision := resolveWeightPrecision(plan.WeightPrecision, r.client)
+	changes := exercisePatch(plan, state, weightUnit, resolveWeightUnit(state.WeightUnit, r.client), weightPrecision)
+
+	var exercise *Exercise
+	if len(changes) > 0 {
+		var err error
+		exercise, err = r.client.PatchExercise(plan.ID.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating exercise",
+				"Could not update exercise, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
-	// Fetch updated items from GetOrder as UpdateOrder items are not
-	// populated.
-	exercise, err := r.client.GetExercise(plan.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading exercise",
-			"Could not read exercise ID "+plan.ID.String()+": "+err.Error(),
-		)
-		return
+	// Only fetch the exercise when nothing was sent or the PATCH response
+	// did not include it.
+	if exercise == nil {
+		var err error
+		exercise, err = r.client.GetExercise(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading exercise",
+				"Could not read exercise ID "+plan.ID.String()+": "+err.Error(),
+			)
+			return
+		}
 	}
 
-	plan.Name = types.StringValue(exercise.Name)
-	plan.DefaultWeight = types.Float32Value(exercise.DefaultWeight)
+	resp.Diagnostics.Append(plan.refresh(exercise, weightUnit, weightPrecision)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.refreshProgress(exercise, weightUnit, weightPrecision)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -168,6 +388,68 @@ func (r *exerciseResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 }
 
+// exercisePatch returns the API attributes that differ between plan and state.
+// Optional attributes removed from the configuration are sent as null so the
+// API clears them. Weights only differ by more than weightPrecision.
+func exercisePatch(plan, state exerciseResourceModel, planWeightUnit, stateWeightUnit string, weightPrecision float64) map[string]any {
+	changes := map[string]any{}
+
+	if !plan.Name.Equal(state.Name) {
+		changes["name"] = plan.Name.ValueString()
+	}
+	if weightChanged(plan.loadedDefaultWeight(), planWeightUnit, state.loadedDefaultWeight(), stateWeightUnit, weightPrecision) {
+		changes["default_weight"] = toPounds(plan.loadedDefaultWeight().ValueFloat32(), planWeightUnit)
+	}
+	if !plan.PrimaryMuscles.Equal(state.PrimaryMuscles) {
+		changes["primary_muscles"] = stringSetPatchValue(plan.PrimaryMuscles)
+	}
+	if !plan.SecondaryMuscles.Equal(state.SecondaryMuscles) {
+		changes["secondary_muscles"] = stringSetPatchValue(plan.SecondaryMuscles)
+	}
+	if !plan.Equipment.Equal(state.Equipment) {
+		changes["equipment"] = plan.Equipment.ValueStringPointer()
+	}
+	if !plan.Category.Equal(state.Category) {
+		changes["category"] = plan.Category.ValueStringPointer()
+	}
+	if !plan.MovementPattern.Equal(state.MovementPattern) {
+		changes["movement_pattern"] = plan.MovementPattern.ValueStringPointer()
+	}
+	if !plan.Notes.Equal(state.Notes) {
+		changes["notes"] = plan.Notes.ValueStringPointer()
+	}
+	if !plan.EquipmentProfileID.Equal(state.EquipmentProfileID) {
+		changes["equipment_profile_id"] = plan.EquipmentProfileID.ValueInt64Pointer()
+	}
+
+	return changes
+}
+
+// loadedDefaultWeight returns the default weight that is sent to the API:
+// loadable_default_weight once it is known, and default_weight otherwise.
+func (m *exerciseResourceModel) loadedDefaultWeight() WeightValue {
+	if m.LoadableDefaultWeight.IsNull() || m.LoadableDefaultWeight.IsUnknown() {
+		return m.DefaultWeight
+	}
+	return NewWeightValue(m.LoadableDefaultWeight.ValueFloat32(), defaultWeightPrecision)
+}
+
+// stringSetPatchValue returns the elements of a set of strings, or nil for a
+// null set.
+func stringSetPatchValue(set types.Set) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	values := make([]string, 0, len(set.Elements()))
+	for _, element := range set.Elements() {
+		if value, ok := element.(types.String); ok {
+			values = append(values, value.ValueString())
+		}
+	}
+	return values
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *exerciseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
@@ -178,6 +460,24 @@ func (r *exerciseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// Archiving keeps the logged history, so it is allowed even when
+	// deletion protection is enabled.
+	if state.OnDestroy.ValueString() == onDestroyArchive {
+		err := r.client.ArchiveExercise(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Archiving BrickByBrick Exercise",
+				"Could not archive exercise, unexpected error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError("Exercise", state.Name.String()))
+		return
+	}
+
 	// Delete existing exercise
 	err := r.client.DeleteExercise(state.ID.ValueString())
 	if err != nil {
@@ -214,6 +514,7 @@ func (r *exerciseResource) Configure(_ context.Context, req resource.ConfigureRe
 // Schema defines the schema for the resource.
 func (r *exerciseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -222,6 +523,13 @@ func (r *exerciseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"exercise_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The unique identifier for the exercise as a number, matching the id returned by the brickbybrick_exercises data source.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the exercise",
@@ -233,7 +541,8 @@ func (r *exerciseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"default_weight": schema.Float32Attribute{
-				Description: "The starting weight for the first session of this exercise. Measured in lbs. Defaults to 5.",
+				Description: "The starting weight for the first session of this exercise. Measured in the configured weight_unit. Defaults to 5.",
+				CustomType:  WeightType{Precision: defaultWeightPrecision},
 				Optional:    true,
 				Computed:    true,
 				Default:     float32default.StaticFloat32(5),
@@ -242,11 +551,475 @@ func (r *exerciseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					float32validator.AtMost(10000),
 				},
 			},
+			"primary_muscles": schema.SetAttribute{
+				Description: "The muscle groups primarily trained by this exercise.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(exerciseMuscleGroups...)),
+				},
+			},
+			"secondary_muscles": schema.SetAttribute{
+				Description: "The muscle groups trained as a secondary effect of this exercise.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(exerciseMuscleGroups...)),
+				},
+			},
+			"equipment": schema.StringAttribute{
+				Description: "The equipment needed to perform the exercise.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(exerciseEquipment...),
+				},
+			},
+			"category": schema.StringAttribute{
+				Description: "Whether the exercise is a compound or an isolation movement.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(exerciseCategories...),
+				},
+			},
+			"movement_pattern": schema.StringAttribute{
+				Description: "The movement pattern the exercise trains.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(exerciseMovementPatterns...),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Description: "Free-text notes about the exercise, such as cues or setup instructions.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2000),
+				},
+			},
+			"weight_unit": schema.StringAttribute{
+				Description: "The unit, lb or kg, that default_weight is expressed in. Defaults to the provider weight_unit.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"weight_precision": schema.Float64Attribute{
+				Description: "The smallest difference, in weight_unit, between default_weight and the weight stored by the API that counts as a change. current_weight and estimated_one_rep_max are rounded to it. Must be at least 0.01. Defaults to the provider weight_precision.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(defaultWeightPrecision),
+				},
+			},
+			"equipment_profile_id": schema.Int64Attribute{
+				Description: "The equipment_profile_id of the equipment profile the exercise is loaded with. default_weight must then be a weight that the profile's equipment for this exercise's equipment can load, or is snapped to one, according to the profile's on_unloadable_weight.",
+				Optional:    true,
+			},
+			"loadable_default_weight": schema.Float32Attribute{
+				Description: "The default weight that is actually loaded, in the configured weight_unit. This is default_weight snapped to the equipment profile when it cannot be loaded, and default_weight otherwise.",
+				Computed:    true,
+			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What happens to the exercise in BrickByBrick when it is destroyed: delete removes it along with its logged history, archive hides it while keeping the history. Defaults to delete.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(onDestroyDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyModes...),
+				},
+			},
+			"current_weight": schema.Float32Attribute{
+				Description: "The working weight the app has progressed this exercise to, in the configured weight_unit. Managed by the app as sessions are logged.",
+				Computed:    true,
+			},
+			"last_performed_at": schema.StringAttribute{
+				Description: "When the exercise was last performed, in RFC 3339 format. Managed by the app as sessions are logged.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sessions_completed": schema.Int32Attribute{
+				Description: "The number of sessions in which the exercise has been performed. Managed by the app as sessions are logged.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"estimated_one_rep_max": schema.Float32Attribute{
+				Description: "The estimated one-rep max for the exercise, in the configured weight_unit. Managed by the app as sessions are logged.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the exercise was created, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the exercise was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The identifier of the account that owns the exercise.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "Whether creating this resource takes over an existing exercise with the same name instead of creating a duplicate. Defaults to the provider adopt_existing, or false.",
+				Optional:    true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether Terraform is prevented from deleting the exercise and its logged history. Defaults to the provider default_deletion_protection, or true.",
+				Optional:    true,
+				Computed:    true,
+			},
 		},
 	}
 }
 
+// ImportState imports an exercise by its numeric identifier, or by its exact
+// name when the import ID has the form "name:<exercise name>".
 func (r *exerciseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	name, ok := strings.CutPrefix(req.ID, exerciseImportNamePrefix)
+	if !ok {
+		// Retrieve import ID and save to id attribute
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	matches, err := r.client.FindExercisesByName(name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing BrickByBrick Exercise",
+			"Could not list exercises to resolve name "+strconv.Quote(name)+": "+err.Error(),
+		)
+		return
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Exercise Not Found",
+			"No exercise is named "+strconv.Quote(name)+". Names are matched exactly, including case.",
+		)
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(matches[0].ID))...)
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous Exercise Name",
+			fmt.Sprintf("%d exercises are named %q (IDs %s). Import one of them by its numeric ID instead.",
+				len(matches), name, joinExerciseIDs(matches)),
+		)
+	}
+}
+
+// ModifyPlan defaults deletion_protection, checks default_weight against the
+// equipment profile and warns when a protected exercise is planned for
+// replacement.
+func (r *exerciseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the exercise is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planDeletionProtection(ctx, req, resp, r.client, exerciseDeletionProtectionDefault)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.planLoadableDefaultWeight(ctx, req, resp)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state exerciseResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// current_weight and estimated_one_rep_max are expressed in weight_unit
+	// and rounded to weight_precision, so the values in state only carry over
+	// while both are unchanged. Read converts them when the provider settings
+	// that the exercise inherits change.
+	if !plan.WeightUnit.IsUnknown() && !plan.WeightPrecision.IsUnknown() &&
+		resolveWeightUnit(plan.WeightUnit, r.client) == resolveWeightUnit(state.WeightUnit, r.client) &&
+		resolveWeightPrecision(plan.WeightPrecision, r.client) == resolveWeightPrecision(state.WeightPrecision, r.client) {
+		plan.CurrentWeight = state.CurrentWeight
+		plan.EstimatedOneRepMax = state.EstimatedOneRepMax
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Changing the name requires replacement, which deletes the existing
+	// exercise unless it is archived instead.
+	if state.DeletionProtection.ValueBool() && state.OnDestroy.ValueString() != onDestroyArchive &&
+		!plan.Name.IsUnknown() && !plan.Name.Equal(state.Name) {
+		resp.Diagnostics.Append(deletionProtectionReplaceWarning("exercise", state.Name.String()))
+	}
+}
+
+// planLoadableDefaultWeight sets loadable_default_weight to the planned
+// default_weight, checked against the equipment profile when one is
+// referenced. It is left unknown until the values it depends on are known.
+func (r *exerciseResource) planLoadableDefaultWeight(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan exerciseResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DefaultWeight.IsUnknown() || plan.EquipmentProfileID.IsUnknown() || plan.Equipment.IsUnknown() ||
+		plan.WeightUnit.IsUnknown() || plan.WeightPrecision.IsUnknown() {
+		return
+	}
+
+	weightUnit := resolveWeightUnit(plan.WeightUnit, r.client)
+	weightPrecision := resolveWeightPrecision(plan.WeightPrecision, r.client)
+	loadable := roundToPrecision(plan.DefaultWeight.ValueFloat32(), defaultWeightPrecision)
+
+	if !plan.EquipmentProfileID.IsNull() {
+		// Only check when the relevant attributes change, so that the
+		// equipment profile is not fetched on every plan.
+		if !req.State.Raw.IsNull() {
+			var state exerciseResourceModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if !state.LoadableDefaultWeight.IsNull() && plan.EquipmentProfileID.Equal(state.EquipmentProfileID) &&
+				plan.Equipment.Equal(state.Equipment) && plan.WeightUnit.Equal(state.WeightUnit) &&
+				!weightChanged(plan.DefaultWeight, weightUnit, state.DefaultWeight, weightUnit, weightPrecision) {
+				plan.LoadableDefaultWeight = state.LoadableDefaultWeight
+				resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+				return
+			}
+		}
+
+		// The equipment profile cannot be read before the provider has been
+		// configured.
+		if r.client == nil {
+			return
+		}
+
+		equipmentProfile, err := r.client.GetEquipmentProfile(strconv.FormatInt(plan.EquipmentProfileID.ValueInt64(), 10))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("equipment_profile_id"),
+				"Unable to Read BrickByBrick Equipment Profile",
+				"Could not read equipment profile ID "+plan.EquipmentProfileID.String()+" to check default_weight: "+err.Error(),
+			)
+			return
+		}
+
+		var diags diag.Diagnostics
+		loadable, diags = loadableDefaultWeight(equipmentProfile, plan.Equipment.ValueString(), loadable, weightUnit)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.LoadableDefaultWeight = types.Float32Value(loadable)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// exerciseResourceModelV0 is the state of an exercise before exercise_id was
+// added.
+type exerciseResourceModelV0 struct {
+	ID               types.String  `tfsdk:"id"`
+	Name             types.String  `tfsdk:"name"`
+	DefaultWeight    types.Float32 `tfsdk:"default_weight"`
+	PrimaryMuscles   types.Set     `tfsdk:"primary_muscles"`
+	SecondaryMuscles types.Set     `tfsdk:"secondary_muscles"`
+	Equipment        types.String  `tfsdk:"equipment"`
+	Category         types.String  `tfsdk:"category"`
+	MovementPattern  types.String  `tfsdk:"movement_pattern"`
+	Notes            types.String  `tfsdk:"notes"`
+	WeightUnit       types.String  `tfsdk:"weight_unit"`
+}
+
+// UpgradeState migrates state written by earlier versions of the schema.
+func (r *exerciseResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 only stored the identifier as a string in id.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                schema.StringAttribute{Computed: true},
+					"name":              schema.StringAttribute{Required: true},
+					"default_weight":    schema.Float32Attribute{Optional: true, Computed: true},
+					"primary_muscles":   schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"secondary_muscles": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"equipment":         schema.StringAttribute{Optional: true},
+					"category":          schema.StringAttribute{Optional: true},
+					"movement_pattern":  schema.StringAttribute{Optional: true},
+					"notes":             schema.StringAttribute{Optional: true},
+					"weight_unit":       schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorState exerciseResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				exerciseID, err := strconv.ParseInt(priorState.ID.ValueString(), 10, 64)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Upgrade BrickByBrick Exercise State",
+						"Could not parse exercise ID "+priorState.ID.String()+" as a number: "+err.Error(),
+					)
+					return
+				}
+
+				upgradedState := exerciseResourceModel{
+					ID:               priorState.ID,
+					ExerciseID:       types.Int64Value(exerciseID),
+					Name:             priorState.Name,
+					DefaultWeight:    WeightValue{Float32Value: priorState.DefaultWeight, precision: defaultWeightPrecision},
+					PrimaryMuscles:   priorState.PrimaryMuscles,
+					SecondaryMuscles: priorState.SecondaryMuscles,
+					Equipment:        priorState.Equipment,
+					Category:         priorState.Category,
+					MovementPattern:  priorState.MovementPattern,
+					Notes:            priorState.Notes,
+					WeightUnit:       priorState.WeightUnit,
+
+					// Version 0 stored the weight the API returned, so it is
+					// also the loadable weight. Without it, Read would not
+					// keep default_weight when the API reflects progression.
+					LoadableDefaultWeight: priorState.DefaultWeight,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedState)...)
+			},
+		},
+	}
+}
+
+// refresh overwrites the model with the values returned by the API, converting
+// weights into weightUnit. Empty optional values are stored as null so that
+// unset attributes do not produce a diff.
+func (m *exerciseResourceModel) refresh(exercise *Exercise, weightUnit string, weightPrecision float64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(strconv.Itoa(exercise.ID))
+	m.ExerciseID = types.Int64Value(int64(exercise.ID))
+	m.Name = types.StringValue(exercise.Name)
+	m.EquipmentProfileID = types.Int64PointerValue(int64PointerValue(exercise.EquipmentProfileID))
+
+	// The API stores the loadable weight, so default_weight keeps its
+	// configured value for as long as the stored weight is within
+	// weightPrecision of what it was snapped to.
+	loaded := weightFromPounds(exercise.DefaultWeight, weightUnit, defaultWeightPrecision)
+	if m.LoadableDefaultWeight.IsNull() || m.LoadableDefaultWeight.IsUnknown() ||
+		weightChanged(loaded, weightUnit, m.loadedDefaultWeight(), weightUnit, weightPrecision) {
+		m.DefaultWeight = loaded
+		m.LoadableDefaultWeight = types.Float32Value(loaded.ValueFloat32())
+	}
+	m.Equipment = stringValueOrNull(exercise.Equipment)
+	m.Category = stringValueOrNull(exercise.Category)
+	m.MovementPattern = stringValueOrNull(exercise.MovementPattern)
+	m.Notes = stringValueOrNull(exercise.Notes)
+	m.CreatedAt = timestampValueOrNull(exercise.CreatedAt)
+	m.UpdatedAt = timestampValueOrNull(exercise.UpdatedAt)
+	m.OwnerID = stringValueOrNull(exercise.OwnerID)
+
+	m.PrimaryMuscles, diags = stringSetValueOrNull(exercise.PrimaryMuscles)
+	if diags.HasError() {
+		return diags
+	}
+	m.SecondaryMuscles, diags = stringSetValueOrNull(exercise.SecondaryMuscles)
+
+	return diags
+}
+
+// defaultWeightReflectsProgression reports whether the default_weight
+// returned by the API is the working weight the app has progressed the
+// exercise to, rather than a starting weight changed outside of Terraform.
+func defaultWeightReflectsProgression(exercise *Exercise) bool {
+	return exercise.SessionsCompleted > 0 && exercise.CurrentWeight != nil &&
+		toHundredths(exercise.DefaultWeight) == toHundredths(*exercise.CurrentWeight)
+}
+
+// refreshProgress overwrites the read-only progress attributes, which the app
+// manages as sessions are logged, with the values returned by the API.
+func (m *exerciseResourceModel) refreshProgress(exercise *Exercise, weightUnit string, weightPrecision float64) {
+	m.CurrentWeight = weightPointerValue(exercise.CurrentWeight, weightUnit, weightPrecision)
+	m.LastPerformedAt = timestampValueOrNull(exercise.LastPerformedAt)
+	m.SessionsCompleted = types.Int32Value(exercise.SessionsCompleted)
+	m.EstimatedOneRepMax = weightPointerValue(exercise.EstimatedOneRepMax, weightUnit, weightPrecision)
+}
+
+// joinExerciseIDs returns the IDs of exercises as a comma-separated list.
+func joinExerciseIDs(exercises []Exercise) string {
+	ids := make([]string, 0, len(exercises))
+	for _, exercise := range exercises {
+		ids = append(ids, strconv.Itoa(exercise.ID))
+	}
+	return strings.Join(ids, ", ")
+}
+
+// intPointerValue returns the ID in a number attribute to send to the API, or
+// nil when it is null.
+func intPointerValue(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	id := int(value.ValueInt64())
+	return &id
+}
+
+// int64PointerValue returns an ID returned by the API as an int64 pointer.
+func int64PointerValue(value *int) *int64 {
+	if value == nil {
+		return nil
+	}
+	id := int64(*value)
+	return &id
+}
+
+// stringValueOrNull returns a null string for the empty string.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// timestampValueOrNull returns a timestamp from the API in RFC 3339 format,
+// or a null string for the empty string. Timestamps that cannot be parsed are
+// returned unchanged.
+func timestampValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return types.StringValue(value)
+	}
+	return types.StringValue(timestamp.UTC().Format(time.RFC3339))
+}
+
+// stringSetValueOrNull returns a null set for an empty slice.
+func stringSetValueOrNull(values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValue(types.StringType, elements)
 }
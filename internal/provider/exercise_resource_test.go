@@ -0,0 +1,391 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExerciseResourceUpgradeStateV0(t *testing.T) {
+	pounds := func(value float32) *float32 { return &value }
+
+	testCases := map[string]struct {
+		rawState                  string
+		wantExerciseID            int64
+		wantName                  string
+		wantLoadableDefaultWeight *float32
+		wantError                 bool
+	}{
+		"minimal": {
+			rawState:                  `{"id":"42","name":"Dumbbell floor press","default_weight":10}`,
+			wantExerciseID:            42,
+			wantName:                  "Dumbbell floor press",
+			wantLoadableDefaultWeight: pounds(10),
+		},
+		"no-default-weight": {
+			rawState:       `{"id":"43","name":"Pull up"}`,
+			wantExerciseID: 43,
+			wantName:       "Pull up",
+		},
+		"all-attributes": {
+			rawState: `{
+				"id": "7",
+				"name": "Barbell back squat",
+				"default_weight": 135,
+				"primary_muscles": ["quads", "glutes"],
+				"secondary_muscles": ["lower_back"],
+				"equipment": "barbell",
+				"category": "compound",
+				"movement_pattern": "squat",
+				"notes": "High bar.",
+				"weight_unit": "lb"
+			}`,
+			wantExerciseID:            7,
+			wantName:                  "Barbell back squat",
+			wantLoadableDefaultWeight: pounds(135),
+		},
+		"non-numeric-id": {
+			rawState:  `{"id":"abc","name":"Dumbbell floor press","default_weight":10}`,
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state, diags := testUpgradeResourceState(t, "brickbybrick_exercise", 0, testCase.rawState)
+
+			if testCase.wantError {
+				if state != nil {
+					t.Fatalf("expected an error, got upgraded state: %v", state)
+				}
+				if len(diags) == 0 || diags[0].Summary != "Unable to Upgrade BrickByBrick Exercise State" {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if state == nil {
+				t.Fatalf("unexpected error upgrading state: %v", diags)
+			}
+
+			var exerciseID big.Float
+			if err := state["exercise_id"].As(&exerciseID); err != nil {
+				t.Fatalf("unexpected error reading exercise_id: %s", err)
+			}
+			if got, _ := exerciseID.Int64(); got != testCase.wantExerciseID {
+				t.Errorf("expected exercise_id %d, got %d", testCase.wantExerciseID, got)
+			}
+
+			if !state["name"].Equal(tftypes.NewValue(tftypes.String, testCase.wantName)) {
+				t.Errorf("expected name %q, got %s", testCase.wantName, state["name"])
+			}
+
+			var loadableDefaultWeight *big.Float
+			if err := state["loadable_default_weight"].As(&loadableDefaultWeight); err != nil {
+				t.Fatalf("unexpected error reading loadable_default_weight: %s", err)
+			}
+			switch {
+			case testCase.wantLoadableDefaultWeight == nil && loadableDefaultWeight != nil:
+				t.Errorf("expected null loadable_default_weight, got %s", loadableDefaultWeight)
+			case testCase.wantLoadableDefaultWeight != nil && loadableDefaultWeight == nil:
+				t.Errorf("expected loadable_default_weight %g, got null", *testCase.wantLoadableDefaultWeight)
+			case testCase.wantLoadableDefaultWeight != nil:
+				if got, _ := loadableDefaultWeight.Float32(); got != *testCase.wantLoadableDefaultWeight {
+					t.Errorf("expected loadable_default_weight %g, got %g", *testCase.wantLoadableDefaultWeight, got)
+				}
+			}
+		})
+	}
+}
+
+func TestExercisePatch(t *testing.T) {
+	state := exerciseResourceModel{
+		Name:             types.StringValue("Barbell back squat"),
+		DefaultWeight:    NewWeightValue(135, defaultWeightPrecision),
+		PrimaryMuscles:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("quads")}),
+		SecondaryMuscles: types.SetNull(types.StringType),
+		Equipment:        types.StringValue("barbell"),
+		Category:         types.StringNull(),
+		MovementPattern:  types.StringNull(),
+		Notes:            types.StringValue("High bar."),
+	}
+
+	testCases := map[string]struct {
+		modify          func(plan *exerciseResourceModel)
+		planWeightUnit  string
+		stateWeightUnit string
+		weightPrecision float64
+		want            map[string]any
+	}{
+		"unchanged": {
+			modify: func(plan *exerciseResourceModel) {},
+			want:   map[string]any{},
+		},
+		"default-weight": {
+			modify: func(plan *exerciseResourceModel) { plan.DefaultWeight = NewWeightValue(135.2, defaultWeightPrecision) },
+			want:   map[string]any{"default_weight": float32(135.2)},
+		},
+		"default-weight-within-precision": {
+			modify:          func(plan *exerciseResourceModel) { plan.DefaultWeight = NewWeightValue(135.2, defaultWeightPrecision) },
+			weightPrecision: 0.5,
+			want:            map[string]any{},
+		},
+		"default-weight-beyond-precision": {
+			modify:          func(plan *exerciseResourceModel) { plan.DefaultWeight = NewWeightValue(135.5, defaultWeightPrecision) },
+			weightPrecision: 0.5,
+			want:            map[string]any{"default_weight": float32(135.5)},
+		},
+		"name": {
+			modify: func(plan *exerciseResourceModel) { plan.Name = types.StringValue("Low bar squat") },
+			want:   map[string]any{"name": "Low bar squat"},
+		},
+		"removed-notes": {
+			modify: func(plan *exerciseResourceModel) { plan.Notes = types.StringNull() },
+			want:   map[string]any{"notes": (*string)(nil)},
+		},
+		"weight-unit-only": {
+			modify: func(plan *exerciseResourceModel) {
+				plan.DefaultWeight = NewWeightValue(fromPounds(135, weightUnitKilograms), defaultWeightPrecision)
+			},
+			planWeightUnit: weightUnitKilograms,
+			want:           map[string]any{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plan := state
+			testCase.modify(&plan)
+
+			planWeightUnit, stateWeightUnit := testCase.planWeightUnit, testCase.stateWeightUnit
+			if planWeightUnit == "" {
+				planWeightUnit = weightUnitPounds
+			}
+			if stateWeightUnit == "" {
+				stateWeightUnit = weightUnitPounds
+			}
+			weightPrecision := testCase.weightPrecision
+			if weightPrecision == 0 {
+				weightPrecision = defaultWeightPrecision
+			}
+
+			got := exercisePatch(plan, state, planWeightUnit, stateWeightUnit, weightPrecision)
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("expected changes %v, got %v", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestDefaultWeightReflectsProgression(t *testing.T) {
+	pounds := func(value float32) *float32 { return &value }
+
+	testCases := map[string]struct {
+		exercise Exercise
+		want     bool
+	}{
+		"never-performed": {
+			exercise: Exercise{DefaultWeight: 135, CurrentWeight: pounds(135)},
+			want:     false,
+		},
+		"progressed-into-default-weight": {
+			exercise: Exercise{DefaultWeight: 185, CurrentWeight: pounds(185), SessionsCompleted: 10},
+			want:     true,
+		},
+		"starting-weight-changed": {
+			exercise: Exercise{DefaultWeight: 145, CurrentWeight: pounds(185), SessionsCompleted: 10},
+			want:     false,
+		},
+		"no-current-weight": {
+			exercise: Exercise{DefaultWeight: 145, SessionsCompleted: 10},
+			want:     false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := defaultWeightReflectsProgression(&testCase.exercise); got != testCase.want {
+				t.Errorf("expected %t, got %t", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestExerciseResourceImportStateByName(t *testing.T) {
+	exercises := []Exercise{
+		{ID: 1, Name: "Barbell back squat"},
+		{ID: 2, Name: "Barbell bench press"},
+		{ID: 3, Name: "Dumbbell curl"},
+		{ID: 4, Name: "Dumbbell curl"},
+	}
+
+	testCases := map[string]struct {
+		importID    string
+		serverError bool
+		wantID      string
+		wantSummary string
+	}{
+		"numeric-id": {
+			importID: "7",
+			wantID:   "7",
+		},
+		"single-match": {
+			importID: "name:Barbell bench press",
+			wantID:   "2",
+		},
+		"no-match": {
+			importID:    "name:barbell bench press",
+			wantSummary: "Exercise Not Found",
+		},
+		"several-matches": {
+			importID:    "name:Dumbbell curl",
+			wantSummary: "Ambiguous Exercise Name",
+		},
+		"server-error": {
+			importID:    "name:Barbell bench press",
+			serverError: true,
+			wantSummary: "Error Importing BrickByBrick Exercise",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /exercises", func(w http.ResponseWriter, r *http.Request) {
+				if testCase.serverError {
+					http.Error(w, "unavailable", http.StatusServiceUnavailable)
+					return
+				}
+				writeJSON(t, w, exercises)
+			})
+			r := &exerciseResource{client: newTestClient(t, mux)}
+
+			gotID, diags := testImportState(t, r, testCase.importID)
+
+			if testCase.wantSummary != "" {
+				if len(diags) != 1 || diags[0].Summary() != testCase.wantSummary {
+					t.Fatalf("expected error %q, got %v", testCase.wantSummary, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error importing: %v", diags)
+			}
+			if gotID.ValueString() != testCase.wantID {
+				t.Errorf("expected id %q, got %s", testCase.wantID, gotID)
+			}
+		})
+	}
+}
+
+func TestExerciseResourceCreateAdoptExisting(t *testing.T) {
+	testCases := map[string]struct {
+		existing      []Exercise
+		adoptExisting bool
+		wantRequests  []string
+		wantID        string
+		wantSummary   string
+	}{
+		"disabled": {
+			existing:     []Exercise{{ID: 2, Name: "Barbell bench press"}},
+			wantRequests: []string{"POST /exercises"},
+			wantID:       "100",
+		},
+		"no-match": {
+			existing:      []Exercise{{ID: 1, Name: "Barbell back squat"}},
+			adoptExisting: true,
+			wantRequests:  []string{"GET /exercises", "POST /exercises"},
+			wantID:        "100",
+		},
+		"single-match": {
+			existing:      []Exercise{{ID: 1, Name: "Barbell back squat"}, {ID: 2, Name: "Barbell bench press"}},
+			adoptExisting: true,
+			wantRequests:  []string{"GET /exercises", "PUT /exercises/2", "GET /exercises/2"},
+			wantID:        "2",
+		},
+		"several-matches": {
+			existing:      []Exercise{{ID: 2, Name: "Barbell bench press"}, {ID: 3, Name: "Barbell bench press"}},
+			adoptExisting: true,
+			wantRequests:  []string{"GET /exercises"},
+			wantSummary:   "Ambiguous Exercise To Adopt",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /exercises", func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(t, w, testCase.existing)
+			})
+			mux.HandleFunc("POST /exercises", func(w http.ResponseWriter, r *http.Request) {
+				var exercise Exercise
+				if err := json.NewDecoder(r.Body).Decode(&exercise); err != nil {
+					t.Errorf("decoding request: %v", err)
+				}
+				exercise.ID = 100
+				writeJSON(t, w, exercise)
+			})
+			mux.HandleFunc("PUT /exercises/{id}", func(w http.ResponseWriter, r *http.Request) {
+				var exercise Exercise
+				if err := json.NewDecoder(r.Body).Decode(&exercise); err != nil {
+					t.Errorf("decoding request: %v", err)
+				}
+				if exercise.DefaultWeight != 135 {
+					t.Errorf("expected adopted default_weight 135, got %g", exercise.DefaultWeight)
+				}
+				writeJSON(t, w, exercise)
+			})
+			mux.HandleFunc("GET /exercises/{id}", func(w http.ResponseWriter, r *http.Request) {
+				id, _ := strconv.Atoi(r.PathValue("id"))
+				writeJSON(t, w, Exercise{ID: id, Name: "Barbell bench press", DefaultWeight: 135})
+			})
+
+			var requests []string
+			r := &exerciseResource{client: newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				mux.ServeHTTP(w, r)
+			}))}
+
+			state, diags := testCreateResource(t, r, exerciseResourceModel{
+				Name:             types.StringValue("Barbell bench press"),
+				DefaultWeight:    NewWeightValue(135, defaultWeightPrecision),
+				PrimaryMuscles:   types.SetNull(types.StringType),
+				SecondaryMuscles: types.SetNull(types.StringType),
+				AdoptExisting:    types.BoolValue(testCase.adoptExisting),
+			})
+
+			if !slices.Equal(requests, testCase.wantRequests) {
+				t.Errorf("expected requests %v, got %v", testCase.wantRequests, requests)
+			}
+			if testCase.wantSummary != "" {
+				if len(diags) != 1 || diags[0].Summary() != testCase.wantSummary {
+					t.Fatalf("expected error %q, got %v", testCase.wantSummary, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating: %v", diags)
+			}
+
+			var gotID types.String
+			if diags := state.GetAttribute(context.Background(), path.Root("id"), &gotID); diags.HasError() {
+				t.Fatalf("unexpected error reading id: %v", diags)
+			}
+			if gotID.ValueString() != testCase.wantID {
+				t.Errorf("expected id %q, got %s", testCase.wantID, gotID)
+			}
+		})
+	}
+}
@@ -4,11 +4,20 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,6 +27,9 @@ var (
 	_ datasource.DataSourceWithConfigure = &exercisesDataSource{}
 )
 
+// exerciseSortKeys are the values accepted by sort_by.
+var exerciseSortKeys = []string{"id", "name", "default_weight", "created_at", "updated_at"}
+
 func NewExercisesDataSource() datasource.DataSource {
 	return &exercisesDataSource{}
 }
@@ -27,13 +39,32 @@ type exercisesDataSource struct {
 }
 
 type exercisesModel struct {
-	ID            types.Int64   `tfsdk:"id"`
-	Name          types.String  `tfsdk:"name"`
-	DefaultWeight types.Float32 `tfsdk:"default_weight"`
+	ID               types.Int64    `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	DefaultWeight    types.Float32  `tfsdk:"default_weight"`
+	PrimaryMuscles   []types.String `tfsdk:"primary_muscles"`
+	SecondaryMuscles []types.String `tfsdk:"secondary_muscles"`
+	Equipment        types.String   `tfsdk:"equipment"`
+	Category         types.String   `tfsdk:"category"`
+	MovementPattern  types.String   `tfsdk:"movement_pattern"`
+	Notes            types.String   `tfsdk:"notes"`
+	Archived         types.Bool     `tfsdk:"archived"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	OwnerID          types.String   `tfsdk:"owner_id"`
 }
 
 type exercisesDataSourceModel struct {
-	Exercises []exercisesModel `tfsdk:"exercises"`
+	IncludeArchived  types.Bool       `tfsdk:"include_archived"`
+	WeightUnit       types.String     `tfsdk:"weight_unit"`
+	NameRegex        types.String     `tfsdk:"name_regex"`
+	NamePrefix       types.String     `tfsdk:"name_prefix"`
+	MinDefaultWeight types.Float32    `tfsdk:"min_default_weight"`
+	MaxDefaultWeight types.Float32    `tfsdk:"max_default_weight"`
+	MuscleGroups     []types.String   `tfsdk:"muscle_groups"`
+	SortBy           types.String     `tfsdk:"sort_by"`
+	SortOrder        types.String     `tfsdk:"sort_order"`
+	Exercises        []exercisesModel `tfsdk:"exercises"`
 }
 
 // Configure adds the provider configured client to the data source.
@@ -65,6 +96,69 @@ func (d *exercisesDataSource) Metadata(_ context.Context, req datasource.Metadat
 func (d *exercisesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"include_archived": schema.BoolAttribute{
+				Description: "Whether archived exercises are included in the list. Defaults to false.",
+				Optional:    true,
+			},
+			"weight_unit": schema.StringAttribute{
+				Description: "The unit, lb or kg, that weights are returned in. Defaults to the provider weight_unit.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only list exercises whose name matches this regular expression, using RE2 syntax.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only list exercises whose name starts with this prefix. Matching is case sensitive.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"min_default_weight": schema.Float32Attribute{
+				Description: "Only list exercises whose default_weight is at least this weight, in weight_unit.",
+				Optional:    true,
+				Validators: []validator.Float32{
+					float32validator.AtLeast(0),
+				},
+			},
+			"max_default_weight": schema.Float32Attribute{
+				Description: "Only list exercises whose default_weight is at most this weight, in weight_unit.",
+				Optional:    true,
+				Validators: []validator.Float32{
+					float32validator.AtLeast(0),
+				},
+			},
+			"muscle_groups": schema.SetAttribute{
+				Description: "Only list exercises that train at least one of these muscle groups, as a primary or secondary muscle.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(exerciseMuscleGroups...)),
+				},
+			},
+			"sort_by": schema.StringAttribute{
+				Description: "The attribute to sort exercises by: " + strings.Join(exerciseSortKeys, ", ") + ". Defaults to the order returned by the API.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(exerciseSortKeys...),
+				},
+			},
+			"sort_order": schema.StringAttribute{
+				Description: "Whether exercises are sorted in ascending (asc) or descending (desc) order. Requires sort_by. Defaults to asc.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortOrders...),
+					stringvalidator.AlsoRequires(path.MatchRoot("sort_by")),
+				},
+			},
 			"exercises": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "A flat list of exercises associated with your account.",
@@ -79,10 +173,52 @@ func (d *exercisesDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 							Computed:    true,
 						},
 						"default_weight": schema.Float32Attribute{
-							Description: "The starting weight for this exercise. Measured in lbs.",
+							Description: "The starting weight for this exercise. Measured in weight_unit.",
 							Computed:    true,
 							Optional:    true,
 						},
+						"primary_muscles": schema.SetAttribute{
+							Description: "The muscle groups primarily trained by this exercise.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"secondary_muscles": schema.SetAttribute{
+							Description: "The muscle groups trained as a secondary effect of this exercise.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"equipment": schema.StringAttribute{
+							Description: "The equipment needed to perform the exercise.",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "Whether the exercise is a compound or an isolation movement.",
+							Computed:    true,
+						},
+						"movement_pattern": schema.StringAttribute{
+							Description: "The movement pattern the exercise trains.",
+							Computed:    true,
+						},
+						"notes": schema.StringAttribute{
+							Description: "Free-text notes about the exercise.",
+							Computed:    true,
+						},
+						"archived": schema.BoolAttribute{
+							Description: "Whether the exercise has been archived.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the exercise was created, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "When the exercise was last updated, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"owner_id": schema.StringAttribute{
+							Description: "The identifier of the account that owns the exercise.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -93,8 +229,54 @@ func (d *exercisesDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 // Read refreshes the Terraform state with the latest data.
 func (d *exercisesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state exercisesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	weightUnit := resolveWeightUnit(state.WeightUnit, d.client)
+
+	options := ExerciseListOptions{
+		IncludeArchived: state.IncludeArchived.ValueBool(),
+		NamePrefix:      state.NamePrefix.ValueString(),
+		SortBy:          state.SortBy.ValueString(),
+		SortOrder:       state.SortOrder.ValueString(),
+	}
+	if !state.MinDefaultWeight.IsNull() {
+		minDefaultWeight := toPounds(state.MinDefaultWeight.ValueFloat32(), weightUnit)
+		options.MinDefaultWeight = &minDefaultWeight
+	}
+	if !state.MaxDefaultWeight.IsNull() {
+		maxDefaultWeight := toPounds(state.MaxDefaultWeight.ValueFloat32(), weightUnit)
+		options.MaxDefaultWeight = &maxDefaultWeight
+	}
+	if options.MinDefaultWeight != nil && options.MaxDefaultWeight != nil && *options.MinDefaultWeight > *options.MaxDefaultWeight {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_default_weight"),
+			"Invalid Default Weight Range",
+			"max_default_weight must be at least min_default_weight.",
+		)
+		return
+	}
+	for _, muscle := range state.MuscleGroups {
+		options.MuscleGroups = append(options.MuscleGroups, muscle.ValueString())
+	}
 
-	exercises, err := d.client.GetExercises()
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				"name_regex is not a valid regular expression: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	exercises, err := d.client.ListExercises(options)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read BrickByBrick Exercises",
@@ -103,21 +285,95 @@ func (d *exercisesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	// The API may not support every filter, so apply them again here.
+	exercises = filterExercises(exercises, options, nameRegex)
+	sortExercises(exercises, options.SortBy, options.SortOrder)
+
 	// Map response body to model
 	for _, exercise := range exercises {
 		exerciseState := exercisesModel{
-			ID:            types.Int64Value(int64(exercise.ID)),
-			Name:          types.StringValue(exercise.Name),
-			DefaultWeight: types.Float32Value(exercise.DefaultWeight),
+			ID:              types.Int64Value(int64(exercise.ID)),
+			Name:            types.StringValue(exercise.Name),
+			DefaultWeight:   types.Float32Value(fromPounds(exercise.DefaultWeight, weightUnit)),
+			Equipment:       stringValueOrNull(exercise.Equipment),
+			Category:        stringValueOrNull(exercise.Category),
+			MovementPattern: stringValueOrNull(exercise.MovementPattern),
+			Notes:           stringValueOrNull(exercise.Notes),
+			Archived:        types.BoolValue(exercise.Archived),
+			CreatedAt:       timestampValueOrNull(exercise.CreatedAt),
+			UpdatedAt:       timestampValueOrNull(exercise.UpdatedAt),
+			OwnerID:         stringValueOrNull(exercise.OwnerID),
+		}
+		for _, muscle := range exercise.PrimaryMuscles {
+			exerciseState.PrimaryMuscles = append(exerciseState.PrimaryMuscles, types.StringValue(muscle))
+		}
+		for _, muscle := range exercise.SecondaryMuscles {
+			exerciseState.SecondaryMuscles = append(exerciseState.SecondaryMuscles, types.StringValue(muscle))
 		}
 
 		state.Exercises = append(state.Exercises, exerciseState)
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+// filterExercises returns the exercises that match every filter in options
+// and, when it is not nil, nameRegex.
+func filterExercises(exercises []Exercise, options ExerciseListOptions, nameRegex *regexp.Regexp) []Exercise {
+	filtered := []Exercise{}
+	for _, exercise := range exercises {
+		if !options.IncludeArchived && exercise.Archived {
+			continue
+		}
+		if !strings.HasPrefix(exercise.Name, options.NamePrefix) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(exercise.Name) {
+			continue
+		}
+		defaultWeight := roundToPrecision(exercise.DefaultWeight, defaultWeightPrecision)
+		if options.MinDefaultWeight != nil && defaultWeight < roundToPrecision(*options.MinDefaultWeight, defaultWeightPrecision) {
+			continue
+		}
+		if options.MaxDefaultWeight != nil && defaultWeight > roundToPrecision(*options.MaxDefaultWeight, defaultWeightPrecision) {
+			continue
+		}
+		if len(options.MuscleGroups) > 0 && !slices.ContainsFunc(options.MuscleGroups, func(muscle string) bool {
+			return slices.Contains(exercise.PrimaryMuscles, muscle) || slices.Contains(exercise.SecondaryMuscles, muscle)
+		}) {
+			continue
+		}
+		filtered = append(filtered, exercise)
+	}
+	return filtered
+}
+
+// sortExercises sorts exercises in place by one of exerciseSortKeys. An empty
+// sortBy keeps the order returned by the API.
+func sortExercises(exercises []Exercise, sortBy string, sortOrder string) {
+	if sortBy == "" {
+		return
+	}
+
+	slices.SortStableFunc(exercises, func(a, b Exercise) int {
+		var comparison int
+		switch sortBy {
+		case "id":
+			comparison = cmp.Compare(a.ID, b.ID)
+		case "name":
+			comparison = strings.Compare(a.Name, b.Name)
+		case "default_weight":
+			comparison = cmp.Compare(a.DefaultWeight, b.DefaultWeight)
+		case "created_at":
+			comparison = strings.Compare(timestampValueOrNull(a.CreatedAt).ValueString(), timestampValueOrNull(b.CreatedAt).ValueString())
+		case "updated_at":
+			comparison = strings.Compare(timestampValueOrNull(a.UpdatedAt).ValueString(), timestampValueOrNull(b.UpdatedAt).ValueString())
+		}
+		return applySortOrder(comparison, sortOrder)
+	})
+}
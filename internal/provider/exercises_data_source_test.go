@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestFilterAndSortExercises(t *testing.T) {
+	exercises := []Exercise{
+		{ID: 1, Name: "Barbell back squat", DefaultWeight: 135, PrimaryMuscles: []string{"quads"}},
+		{ID: 2, Name: "Barbell bench press", DefaultWeight: 95, PrimaryMuscles: []string{"chest"}, SecondaryMuscles: []string{"triceps"}},
+		{ID: 3, Name: "Dumbbell curl", DefaultWeight: 20, PrimaryMuscles: []string{"biceps"}},
+		{ID: 4, Name: "Barbell row", DefaultWeight: 115, PrimaryMuscles: []string{"upper_back"}, Archived: true},
+	}
+	pounds := func(value float32) *float32 { return &value }
+
+	testCases := map[string]struct {
+		options   ExerciseListOptions
+		nameRegex *regexp.Regexp
+		wantIDs   []int
+	}{
+		"no-filters": {
+			wantIDs: []int{1, 2, 3},
+		},
+		"include-archived": {
+			options: ExerciseListOptions{IncludeArchived: true},
+			wantIDs: []int{1, 2, 3, 4},
+		},
+		"name-prefix-and-regex": {
+			options:   ExerciseListOptions{NamePrefix: "Barbell"},
+			nameRegex: regexp.MustCompile(`(?i)press$`),
+			wantIDs:   []int{2},
+		},
+		"weight-range": {
+			options: ExerciseListOptions{MinDefaultWeight: pounds(95), MaxDefaultWeight: pounds(130)},
+			wantIDs: []int{2},
+		},
+		"secondary-muscle": {
+			options: ExerciseListOptions{MuscleGroups: []string{"triceps", "biceps"}},
+			wantIDs: []int{2, 3},
+		},
+		"sort-by-weight-descending": {
+			options: ExerciseListOptions{SortBy: "default_weight", SortOrder: sortOrderDescending},
+			wantIDs: []int{1, 2, 3},
+		},
+		"sort-by-name": {
+			options: ExerciseListOptions{SortBy: "name"},
+			wantIDs: []int{1, 2, 3},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := filterExercises(slices.Clone(exercises), testCase.options, testCase.nameRegex)
+			sortExercises(got, testCase.options.SortBy, testCase.options.SortOrder)
+
+			gotIDs := []int{}
+			for _, exercise := range got {
+				gotIDs = append(gotIDs, exercise.ID)
+			}
+			if !slices.Equal(gotIDs, testCase.wantIDs) {
+				t.Errorf("expected exercises %v, got %v", testCase.wantIDs, gotIDs)
+			}
+		})
+	}
+}
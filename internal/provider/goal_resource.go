@@ -0,0 +1,567 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &goalResource{}
+	_ resource.ResourceWithConfigure   = &goalResource{}
+	_ resource.ResourceWithImportState = &goalResource{}
+	_ resource.ResourceWithModifyPlan  = &goalResource{}
+)
+
+const (
+	// goalStatusAchieved means the exercise's current weight already meets
+	// the target.
+	goalStatusAchieved = "achieved"
+
+	// goalStatusOnTrack means the strategy is projected to reach the target
+	// by the target date.
+	goalStatusOnTrack = "on_track"
+
+	// goalStatusBehind means the strategy is projected to reach the target
+	// after the target date, or never.
+	goalStatusBehind = "behind"
+)
+
+// NewGoalResource is a helper function to simplify the provider implementation.
+func NewGoalResource() resource.Resource {
+	return &goalResource{}
+}
+
+// goalResource is the resource implementation.
+type goalResource struct {
+	client *BrickByBrickClient
+}
+
+type goalResourceModel struct {
+	ID                       types.String  `tfsdk:"id"`
+	GoalID                   types.Int64   `tfsdk:"goal_id"`
+	ExerciseID               types.Int64   `tfsdk:"exercise_id"`
+	StrategyID               types.Int64   `tfsdk:"strategy_id"`
+	TargetWeight             WeightValue   `tfsdk:"target_weight"`
+	TargetReps               types.Int32   `tfsdk:"target_reps"`
+	TargetDate               types.String  `tfsdk:"target_date"`
+	SessionsPerWeek          types.Int32   `tfsdk:"sessions_per_week"`
+	WeightUnit               types.String  `tfsdk:"weight_unit"`
+	WeightPrecision          types.Float64 `tfsdk:"weight_precision"`
+	Status                   types.String  `tfsdk:"status"`
+	ProjectedAchievementDate types.String  `tfsdk:"projected_achievement_date"`
+	CreatedAt                types.String  `tfsdk:"created_at"`
+	UpdatedAt                types.String  `tfsdk:"updated_at"`
+	OwnerID                  types.String  `tfsdk:"owner_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *goalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_goal"
+}
+
+// Create a new resource.
+func (r *goalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan goalResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	weightUnit := resolveWeightUnit(plan.WeightUnit, r.client)
+	weightPrecision := resolveWeightPrecision(plan.WeightPrecision, r.client)
+
+	newGoal := Goal{
+		ExerciseID:      int(plan.ExerciseID.ValueInt64()),
+		StrategyID:      int(plan.StrategyID.ValueInt64()),
+		TargetWeight:    toPounds(plan.TargetWeight.ValueFloat32(), weightUnit),
+		TargetReps:      plan.TargetReps.ValueInt32(),
+		TargetDate:      plan.TargetDate.ValueString(),
+		SessionsPerWeek: plan.SessionsPerWeek.ValueInt32(),
+	}
+
+	createdGoal, err := r.client.CreateGoal(newGoal)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating goal",
+			"Could not create goal, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.refresh(createdGoal, weightUnit, weightPrecision)
+	if plan.Status.IsUnknown() {
+		resp.Diagnostics.Append(r.project(&plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *goalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state goalResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedGoal, err := r.client.GetGoal(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading BrickByBrick Goal",
+			"Could not read BrickByBrick goal ID "+state.ID.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state. The projection is recalculated
+	// because the exercise progresses as sessions are logged. A goal whose
+	// exercise or strategy can no longer be read must still be refreshed and
+	// destroyed, so projection failures are only warnings.
+	state.refresh(refreshedGoal, resolveWeightUnit(state.WeightUnit, r.client), resolveWeightPrecision(state.WeightPrecision, r.client))
+	if projectionDiags := r.project(&state); projectionDiags.HasError() {
+		for _, projectionDiag := range projectionDiags.Errors() {
+			resp.Diagnostics.AddWarning(
+				projectionDiag.Summary(),
+				projectionDiag.Detail()+"\n\nstatus and projected_achievement_date are null until the goal can be projected again.",
+			)
+		}
+		state.Status = types.StringNull()
+		state.ProjectedAchievementDate = types.StringNull()
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *goalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan goalResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state goalResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from the attributes that changed
+	weightUnit := resolveWeightUnit(plan.WeightUnit, r.client)
+	weightPrecision := resolveWeightPrecision(plan.WeightPrecision, r.client)
+	changes := map[string]any{}
+	if !plan.ExerciseID.Equal(state.ExerciseID) {
+		changes["exercise_id"] = plan.ExerciseID.ValueInt64()
+	}
+	if !plan.StrategyID.Equal(state.StrategyID) {
+		changes["strategy_id"] = plan.StrategyID.ValueInt64()
+	}
+	if weightChanged(plan.TargetWeight, weightUnit, state.TargetWeight, resolveWeightUnit(state.WeightUnit, r.client), weightPrecision) {
+		changes["target_weight"] = toPounds(plan.TargetWeight.ValueFloat32(), weightUnit)
+	}
+	if !plan.TargetReps.Equal(state.TargetReps) {
+		changes["target_reps"] = plan.TargetReps.ValueInt32()
+	}
+	if !plan.TargetDate.Equal(state.TargetDate) {
+		changes["target_date"] = plan.TargetDate.ValueString()
+	}
+	if !plan.SessionsPerWeek.Equal(state.SessionsPerWeek) {
+		changes["sessions_per_week"] = plan.SessionsPerWeek.ValueInt32()
+	}
+
+	var goal *Goal
+	if len(changes) > 0 {
+		var err error
+		goal, err = r.client.PatchGoal(plan.ID.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating goal",
+				"Could not update goal, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Only fetch the goal when nothing was sent or the PATCH response did
+	// not include it.
+	if goal == nil {
+		var err error
+		goal, err = r.client.GetGoal(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading goal",
+				"Could not read goal ID "+plan.ID.String()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.refresh(goal, weightUnit, weightPrecision)
+	if plan.Status.IsUnknown() {
+		resp.Diagnostics.Append(r.project(&plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *goalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state goalResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGoal(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting BrickByBrick Goal",
+			"Could not delete goal, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *goalResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *BrickByBrickClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *goalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A dated strength target for an exercise, with a projection of when the strategy that trains it will reach the target.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the goal",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"goal_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The unique identifier for the goal as a number.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"exercise_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The exercise_id of the exercise the goal is for. Its current_weight, or default_weight before any session is logged, is where the projection starts.",
+			},
+			"strategy_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The strategy_id of the strategy that trains towards the goal. Its overload_rate and target_reps_per_set drive the projection.",
+			},
+			"target_weight": schema.Float32Attribute{
+				Required:    true,
+				CustomType:  WeightType{Precision: defaultWeightPrecision},
+				Description: "The weight to lift, in the configured weight_unit.",
+				Validators: []validator.Float32{
+					float32validator.AtLeast(0),
+					float32validator.AtMost(10000),
+				},
+			},
+			"target_reps": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(1),
+				Description: "The number of reps to lift target_weight for. Defaults to 1.",
+				Validators: []validator.Int32{
+					int32validator.Between(1, 100),
+				},
+			},
+			"target_date": schema.StringAttribute{
+				Required:    true,
+				Description: "The date, in YYYY-MM-DD format, to reach the target by.",
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"sessions_per_week": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(2),
+				Description: "How many sessions of the exercise are performed each week, which sets how quickly overload_rate is applied. Defaults to 2.",
+				Validators: []validator.Int32{
+					int32validator.Between(1, 14),
+				},
+			},
+			"weight_unit": schema.StringAttribute{
+				Optional:    true,
+				Description: "The unit, lb or kg, that target_weight is expressed in. Defaults to the provider weight_unit.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"weight_precision": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The smallest difference, in weight_unit, between target_weight and the weight stored by the API that counts as a change. Must be at least 0.01. Defaults to the provider weight_precision.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(defaultWeightPrecision),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Whether the goal is achieved, on_track to be reached by target_date, or behind. Recalculated from the exercise's progress each time Terraform runs.",
+			},
+			"projected_achievement_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date, in YYYY-MM-DD format, that the strategy is projected to reach the target, counting from today. Null once the goal is achieved, or when the strategy's overload_rate is 0.",
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the goal was created, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the goal was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The identifier of the account that owns the goal.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *goalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan projects the goal so that status and projected_achievement_date
+// are known at plan time.
+func (r *goalResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the goal is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan goalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read has just projected the goal in state, so it only needs projecting
+	// again when the goal changes.
+	if !req.State.Raw.IsNull() {
+		var state goalResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.ExerciseID.Equal(state.ExerciseID) && plan.StrategyID.Equal(state.StrategyID) &&
+			plan.TargetWeight.Equal(state.TargetWeight) && plan.TargetReps.Equal(state.TargetReps) &&
+			plan.TargetDate.Equal(state.TargetDate) && plan.SessionsPerWeek.Equal(state.SessionsPerWeek) &&
+			plan.WeightUnit.Equal(state.WeightUnit) {
+			plan.Status = state.Status
+			plan.ProjectedAchievementDate = state.ProjectedAchievementDate
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+			return
+		}
+	}
+
+	// An exercise or strategy created in the same apply cannot be looked up
+	// yet, and neither can anything before the provider is configured.
+	if r.client == nil || plan.ExerciseID.IsUnknown() || plan.StrategyID.IsUnknown() || plan.TargetWeight.IsUnknown() ||
+		plan.TargetReps.IsUnknown() || plan.TargetDate.IsUnknown() || plan.SessionsPerWeek.IsUnknown() || plan.WeightUnit.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.project(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// project sets status and projected_achievement_date from the goal's exercise
+// and strategy.
+func (r *goalResource) project(m *goalResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	exercise, err := r.client.GetExercise(strconv.FormatInt(m.ExerciseID.ValueInt64(), 10))
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("exercise_id"),
+			"Unable to Read BrickByBrick Exercise",
+			"Could not read exercise ID "+m.ExerciseID.String()+" to project the goal: "+err.Error(),
+		)
+		return diags
+	}
+
+	strategy, err := r.client.GetStrategy(strconv.FormatInt(m.StrategyID.ValueInt64(), 10))
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("strategy_id"),
+			"Unable to Read BrickByBrick Strategy",
+			"Could not read strategy ID "+m.StrategyID.String()+" to project the goal: "+err.Error(),
+		)
+		return diags
+	}
+
+	// target_date is validated, but an unexpected value from the API is
+	// left unprojected rather than failing.
+	targetDate, err := time.Parse(dateLayout, m.TargetDate.ValueString())
+	if err != nil {
+		m.Status = types.StringNull()
+		m.ProjectedAchievementDate = types.StringNull()
+		return diags
+	}
+
+	currentWeight := exercise.DefaultWeight
+	if exercise.CurrentWeight != nil {
+		currentWeight = *exercise.CurrentWeight
+	}
+	today, _ := time.Parse(dateLayout, time.Now().Format(dateLayout))
+
+	status, projected := projectGoal(goalProjection{
+		CurrentWeight:   currentWeight,
+		OverloadRate:    strategy.OverloadRate,
+		RepsPerSet:      strategy.TargetRepsPerSet,
+		TargetWeight:    toPounds(m.TargetWeight.ValueFloat32(), resolveWeightUnit(m.WeightUnit, r.client)),
+		TargetReps:      m.TargetReps.ValueInt32(),
+		SessionsPerWeek: m.SessionsPerWeek.ValueInt32(),
+	}, today, targetDate)
+
+	m.Status = types.StringValue(status)
+	if projected.IsZero() {
+		m.ProjectedAchievementDate = types.StringNull()
+	} else {
+		m.ProjectedAchievementDate = types.StringValue(projected.Format(dateLayout))
+	}
+	return diags
+}
+
+// goalProjection holds the inputs of projectGoal. Weights are in lbs.
+type goalProjection struct {
+	CurrentWeight   float32
+	OverloadRate    float32
+	RepsPerSet      int32
+	TargetWeight    float32
+	TargetReps      int32
+	SessionsPerWeek int32
+}
+
+// projectGoal returns the status of a goal and the date it is projected to be
+// achieved, which is zero when it already is or never will be. The target is
+// converted, by estimated one-rep max, into the equivalent weight for the
+// strategy's reps per set, which overload_rate is added to every session.
+func projectGoal(projection goalProjection, today, targetDate time.Time) (string, time.Time) {
+	equivalentTarget := weightForReps(estimatedOneRepMax(projection.TargetWeight, projection.TargetReps), projection.RepsPerSet)
+	remaining := roundToPrecision(equivalentTarget-projection.CurrentWeight, defaultWeightPrecision)
+	if remaining <= defaultWeightPrecision/2 {
+		return goalStatusAchieved, time.Time{}
+	}
+	if projection.OverloadRate <= 0 || projection.SessionsPerWeek <= 0 {
+		return goalStatusBehind, time.Time{}
+	}
+
+	sessions := math.Ceil(float64(remaining / projection.OverloadRate))
+	days := int(math.Ceil(sessions * 7 / float64(projection.SessionsPerWeek)))
+	projected := today.AddDate(0, 0, days)
+
+	if projected.After(targetDate) {
+		return goalStatusBehind, projected
+	}
+	return goalStatusOnTrack, projected
+}
+
+// refresh maps a goal returned by the API onto the model, converting
+// target_weight into weightUnit. target_weight keeps its configured value
+// while the stored weight is within weightPrecision of it.
+func (m *goalResourceModel) refresh(goal *Goal, weightUnit string, weightPrecision float64) {
+	m.ID = types.StringValue(strconv.Itoa(goal.ID))
+	m.GoalID = types.Int64Value(int64(goal.ID))
+	m.ExerciseID = types.Int64Value(int64(goal.ExerciseID))
+	m.StrategyID = types.Int64Value(int64(goal.StrategyID))
+	targetWeight := weightFromPounds(goal.TargetWeight, weightUnit, defaultWeightPrecision)
+	if m.TargetWeight.IsNull() || m.TargetWeight.IsUnknown() || weightChanged(targetWeight, weightUnit, m.TargetWeight, weightUnit, weightPrecision) {
+		m.TargetWeight = targetWeight
+	}
+	m.TargetReps = types.Int32Value(goal.TargetReps)
+	m.TargetDate = types.StringValue(goal.TargetDate)
+	m.SessionsPerWeek = types.Int32Value(goal.SessionsPerWeek)
+	m.CreatedAt = timestampValueOrNull(goal.CreatedAt)
+	m.UpdatedAt = timestampValueOrNull(goal.UpdatedAt)
+	m.OwnerID = stringValueOrNull(goal.OwnerID)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProjectGoal(t *testing.T) {
+	date := func(value string) time.Time {
+		parsed, err := time.Parse(dateLayout, value)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %v", value, err)
+		}
+		return parsed
+	}
+	today := date("2026-01-05")
+
+	testCases := map[string]struct {
+		projection    goalProjection
+		targetDate    string
+		wantStatus    string
+		wantProjected string
+	}{
+		"achieved": {
+			projection: goalProjection{CurrentWeight: 225, OverloadRate: 5, RepsPerSet: 5, TargetWeight: 225, TargetReps: 5, SessionsPerWeek: 2},
+			targetDate: "2026-03-01",
+			wantStatus: goalStatusAchieved,
+		},
+		"on-track": {
+			// 20 lb at 5 lb a session is 4 sessions, or 14 days at 2 a week.
+			projection:    goalProjection{CurrentWeight: 205, OverloadRate: 5, RepsPerSet: 5, TargetWeight: 225, TargetReps: 5, SessionsPerWeek: 2},
+			targetDate:    "2026-03-01",
+			wantStatus:    goalStatusOnTrack,
+			wantProjected: "2026-01-19",
+		},
+		"behind": {
+			projection:    goalProjection{CurrentWeight: 135, OverloadRate: 2.5, RepsPerSet: 5, TargetWeight: 225, TargetReps: 5, SessionsPerWeek: 1},
+			targetDate:    "2026-03-01",
+			wantStatus:    goalStatusBehind,
+			wantProjected: "2026-09-14",
+		},
+		"single-rep-target": {
+			// A 1 rep max of 245 lb is 210 lb for 5 reps, 2 sessions away
+			// at 5 lb a session, or 5 days at 3 a week.
+			projection:    goalProjection{CurrentWeight: 200, OverloadRate: 5, RepsPerSet: 5, TargetWeight: 245, TargetReps: 1, SessionsPerWeek: 3},
+			targetDate:    "2026-01-12",
+			wantStatus:    goalStatusOnTrack,
+			wantProjected: "2026-01-10",
+		},
+		"no-overload": {
+			projection: goalProjection{CurrentWeight: 200, OverloadRate: 0, RepsPerSet: 5, TargetWeight: 225, TargetReps: 5, SessionsPerWeek: 3},
+			targetDate: "2026-03-01",
+			wantStatus: goalStatusBehind,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			status, projected := projectGoal(testCase.projection, today, date(testCase.targetDate))
+			if status != testCase.wantStatus {
+				t.Errorf("status = %q, want %q", status, testCase.wantStatus)
+			}
+
+			gotProjected := ""
+			if !projected.IsZero() {
+				gotProjected = projected.Format(dateLayout)
+			}
+			if gotProjected != testCase.wantProjected {
+				t.Errorf("projected = %q, want %q", gotProjected, testCase.wantProjected)
+			}
+		})
+	}
+}
+
+func TestGoalRefreshTargetWeight(t *testing.T) {
+	testCases := map[string]struct {
+		configured      WeightValue
+		stored          float32
+		weightUnit      string
+		weightPrecision float64
+		want            float32
+	}{
+		"imported": {
+			configured:      WeightValue{Float32Value: types.Float32Null(), precision: defaultWeightPrecision},
+			stored:          225,
+			weightUnit:      weightUnitPounds,
+			weightPrecision: defaultWeightPrecision,
+			want:            225,
+		},
+		"kilogram-round-trip": {
+			configured:      NewWeightValue(100, defaultWeightPrecision),
+			stored:          toPounds(100, weightUnitKilograms),
+			weightUnit:      weightUnitKilograms,
+			weightPrecision: defaultWeightPrecision,
+			want:            100,
+		},
+		"changed-outside-terraform": {
+			configured:      NewWeightValue(100, defaultWeightPrecision),
+			stored:          toPounds(100.2, weightUnitKilograms),
+			weightUnit:      weightUnitKilograms,
+			weightPrecision: defaultWeightPrecision,
+			want:            100.2,
+		},
+		"within-coarse-precision": {
+			configured:      NewWeightValue(100, defaultWeightPrecision),
+			stored:          toPounds(100.2, weightUnitKilograms),
+			weightUnit:      weightUnitKilograms,
+			weightPrecision: 0.5,
+			want:            100,
+		},
+		"beyond-coarse-precision": {
+			configured:      NewWeightValue(100, defaultWeightPrecision),
+			stored:          toPounds(101, weightUnitKilograms),
+			weightUnit:      weightUnitKilograms,
+			weightPrecision: 0.5,
+			want:            101,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := goalResourceModel{TargetWeight: testCase.configured}
+			m.refresh(&Goal{ID: 1, TargetWeight: testCase.stored}, testCase.weightUnit, testCase.weightPrecision)
+			if got := m.TargetWeight.ValueFloat32(); got != roundToPrecision(testCase.want, defaultWeightPrecision) {
+				t.Errorf("expected target_weight %g, got %g", testCase.want, got)
+			}
+		})
+	}
+}
@@ -4,9 +4,32 @@
 package provider
 
 type Exercise struct {
-	ID            int     `json:"id"`
-	Name          string  `json:"name"`
-	DefaultWeight float32 `json:"default_weight"`
+	ID               int      `json:"id"`
+	Name             string   `json:"name"`
+	DefaultWeight    float32  `json:"default_weight"`
+	PrimaryMuscles   []string `json:"primary_muscles"`
+	SecondaryMuscles []string `json:"secondary_muscles"`
+	Equipment        string   `json:"equipment"`
+	Category         string   `json:"category"`
+	MovementPattern  string   `json:"movement_pattern"`
+	Notes            string   `json:"notes"`
+	Archived         bool     `json:"archived,omitempty"`
+
+	// EquipmentProfileID references the equipment profile that DefaultWeight
+	// must be loadable on.
+	EquipmentProfileID *int `json:"equipment_profile_id,omitempty"`
+
+	// Progression managed by the app as sessions are logged. These are never
+	// sent to the API.
+	CurrentWeight      *float32 `json:"current_weight,omitempty"`
+	LastPerformedAt    string   `json:"last_performed_at,omitempty"`
+	SessionsCompleted  int32    `json:"sessions_completed,omitempty"`
+	EstimatedOneRepMax *float32 `json:"estimated_one_rep_max,omitempty"`
+
+	// Metadata managed by the API. These are never sent to the API.
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	OwnerID   string `json:"owner_id,omitempty"`
 }
 
 type Strategy struct {
@@ -16,6 +39,11 @@ type Strategy struct {
 	ExercisesPerWorkout   int32   `json:"exercises_per_workout"`
 	TargetRepsPerSet      int32   `json:"target_reps_per_set"`
 	TargetSetsPerExercise int32   `json:"target_sets_per_exercise"`
+	Archived              bool    `json:"archived"`
+	EquipmentProfileID    *int    `json:"equipment_profile_id,omitempty"`
+	CreatedAt             string  `json:"created_at,omitempty"`
+	UpdatedAt             string  `json:"updated_at,omitempty"`
+	OwnerID               string  `json:"owner_id,omitempty"`
 }
 
 type CreateStrategyPayload struct {
@@ -24,4 +52,164 @@ type CreateStrategyPayload struct {
 	ExercisesPerWorkout   int32   `json:"exercises_per_workout"`
 	TargetRepsPerSet      int32   `json:"target_reps_per_set"`
 	TargetSetsPerExercise int32   `json:"target_sets_per_exercise"`
+	EquipmentProfileID    *int    `json:"equipment_profile_id,omitempty"`
+}
+
+type WorkoutTemplate struct {
+	ID         int                       `json:"id"`
+	Name       string                    `json:"name"`
+	StrategyID int                       `json:"strategy_id"`
+	Exercises  []WorkoutTemplateExercise `json:"exercises"`
+	CreatedAt  string                    `json:"created_at,omitempty"`
+	UpdatedAt  string                    `json:"updated_at,omitempty"`
+	OwnerID    string                    `json:"owner_id,omitempty"`
+}
+
+// WorkoutTemplateExercise is an exercise in a workout template. Sets, reps
+// and rest are only set when they override the template's strategy.
+type WorkoutTemplateExercise struct {
+	ExerciseID  int    `json:"exercise_id"`
+	Sets        *int32 `json:"sets,omitempty"`
+	Reps        *int32 `json:"reps,omitempty"`
+	RestSeconds *int32 `json:"rest_seconds,omitempty"`
+}
+
+type Program struct {
+	ID        int            `json:"id"`
+	Name      string         `json:"name"`
+	StartDate string         `json:"start_date"`
+	Phases    []ProgramPhase `json:"phases"`
+	CreatedAt string         `json:"created_at,omitempty"`
+	UpdatedAt string         `json:"updated_at,omitempty"`
+	OwnerID   string         `json:"owner_id,omitempty"`
+}
+
+type ProgramPhase struct {
+	Name               string `json:"name,omitempty"`
+	DurationWeeks      int32  `json:"duration_weeks"`
+	StrategyID         int    `json:"strategy_id"`
+	WorkoutTemplateIDs []int  `json:"workout_template_ids"`
+}
+
+// Schedule assigns workout templates to dates, either by weekday or by a
+// recurrence rule that rotates through a list of workout templates.
+type Schedule struct {
+	ID            int            `json:"id"`
+	Name          string         `json:"name"`
+	Timezone      string         `json:"timezone"`
+	StartDate     string         `json:"start_date,omitempty"`
+	Weekdays      map[string]int `json:"weekdays,omitempty"`
+	Recurrence    string         `json:"recurrence,omitempty"`
+	Rotation      []int          `json:"rotation,omitempty"`
+	ExcludedDates []string       `json:"excluded_dates,omitempty"`
+	CreatedAt     string         `json:"created_at,omitempty"`
+	UpdatedAt     string         `json:"updated_at,omitempty"`
+	OwnerID       string         `json:"owner_id,omitempty"`
+}
+
+// ExerciseGroup groups exercises of a workout template that are performed
+// back to back, such as a superset.
+type ExerciseGroup struct {
+	ID                          int    `json:"id"`
+	Name                        string `json:"name"`
+	WorkoutTemplateID           int    `json:"workout_template_id"`
+	Type                        string `json:"type"`
+	ExerciseIDs                 []int  `json:"exercise_ids"`
+	Rounds                      int32  `json:"rounds"`
+	RestBetweenExercisesSeconds int32  `json:"rest_between_exercises_seconds"`
+	RestBetweenRoundsSeconds    int32  `json:"rest_between_rounds_seconds"`
+	CreatedAt                   string `json:"created_at,omitempty"`
+	UpdatedAt                   string `json:"updated_at,omitempty"`
+	OwnerID                     string `json:"owner_id,omitempty"`
+}
+
+// EquipmentProfile describes the equipment an athlete can load. Unlike other
+// weights, which the API stores in lbs, its weights are stored in its own
+// WeightUnit because plates and dumbbells are made in fixed steps of a unit.
+type EquipmentProfile struct {
+	ID                 int           `json:"id"`
+	Name               string        `json:"name"`
+	WeightUnit         string        `json:"weight_unit"`
+	Barbells           []Barbell     `json:"barbells,omitempty"`
+	Plates             []Plate       `json:"plates,omitempty"`
+	DumbbellSets       []WeightRange `json:"dumbbell_sets,omitempty"`
+	Machines           []WeightRange `json:"machines,omitempty"`
+	OnUnloadableWeight string        `json:"on_unloadable_weight"`
+	CreatedAt          string        `json:"created_at,omitempty"`
+	UpdatedAt          string        `json:"updated_at,omitempty"`
+	OwnerID            string        `json:"owner_id,omitempty"`
+}
+
+type Barbell struct {
+	Name   string  `json:"name"`
+	Weight float32 `json:"weight"`
+}
+
+// Plate is a plate weight that is available in Pairs, one plate for each side
+// of a barbell.
+type Plate struct {
+	Weight float32 `json:"weight"`
+	Pairs  int32   `json:"pairs"`
+}
+
+// WeightRange is equipment that can be set to any weight from MinWeight to
+// MaxWeight in steps of Increment, such as a dumbbell set or a machine stack.
+type WeightRange struct {
+	Name      string  `json:"name,omitempty"`
+	MinWeight float32 `json:"min_weight"`
+	MaxWeight float32 `json:"max_weight"`
+	Increment float32 `json:"increment"`
+}
+
+// Goal is a target weight and number of reps for an exercise that should be
+// reached by TargetDate, following a strategy.
+type Goal struct {
+	ID              int     `json:"id"`
+	ExerciseID      int     `json:"exercise_id"`
+	StrategyID      int     `json:"strategy_id"`
+	TargetWeight    float32 `json:"target_weight"`
+	TargetReps      int32   `json:"target_reps"`
+	TargetDate      string  `json:"target_date"`
+	SessionsPerWeek int32   `json:"sessions_per_week"`
+	CreatedAt       string  `json:"created_at,omitempty"`
+	UpdatedAt       string  `json:"updated_at,omitempty"`
+	OwnerID         string  `json:"owner_id,omitempty"`
+}
+
+// WorkoutSession is a logged workout. Weights are in lbs.
+type WorkoutSession struct {
+	ID                int                      `json:"id"`
+	Date              string                   `json:"date"`
+	StrategyID        int                      `json:"strategy_id"`
+	WorkoutTemplateID *int                     `json:"workout_template_id,omitempty"`
+	Exercises         []WorkoutSessionExercise `json:"exercises"`
+	ExerciseGroups    []WorkoutSessionGroup    `json:"exercise_groups,omitempty"`
+}
+
+type WorkoutSessionExercise struct {
+	ExerciseID int          `json:"exercise_id"`
+	Sets       []WorkoutSet `json:"sets"`
+}
+
+type WorkoutSet struct {
+	Weight    float32 `json:"weight"`
+	Reps      int32   `json:"reps"`
+	Completed bool    `json:"completed"`
+}
+
+// WorkoutSessionGroup is an exercise group of the session's workout template,
+// as it was when the session was logged.
+type WorkoutSessionGroup struct {
+	ExerciseGroupID int    `json:"exercise_group_id"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	Rounds          int32  `json:"rounds"`
+	ExerciseIDs     []int  `json:"exercise_ids"`
+}
+
+// WorkoutSessionPage is one page of workout sessions, with the number of
+// sessions across all pages.
+type WorkoutSessionPage struct {
+	Sessions   []WorkoutSession `json:"sessions"`
+	TotalCount int32            `json:"total_count"`
 }
@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nextWorkoutDataSource{}
+	_ datasource.DataSourceWithConfigure = &nextWorkoutDataSource{}
+)
+
+func NewNextWorkoutDataSource() datasource.DataSource {
+	return &nextWorkoutDataSource{}
+}
+
+type nextWorkoutDataSource struct {
+	client *BrickByBrickClient
+}
+
+type nextWorkoutDataSourceModel struct {
+	StrategyID          types.Int64                `tfsdk:"strategy_id"`
+	WorkoutTemplateID   types.Int64                `tfsdk:"workout_template_id"`
+	WeightUnit          types.String               `tfsdk:"weight_unit"`
+	WorkoutTemplateName types.String               `tfsdk:"workout_template_name"`
+	Exercises           []nextWorkoutExerciseModel `tfsdk:"exercises"`
+	ExerciseGroups      []workoutSessionGroupModel `tfsdk:"exercise_groups"`
+}
+
+type nextWorkoutExerciseModel struct {
+	ExerciseID  types.Int64   `tfsdk:"exercise_id"`
+	Name        types.String  `tfsdk:"name"`
+	Sets        types.Int32   `tfsdk:"sets"`
+	Reps        types.Int32   `tfsdk:"reps"`
+	Weight      types.Float32 `tfsdk:"weight"`
+	RestSeconds types.Int32   `tfsdk:"rest_seconds"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nextWorkoutDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *nextWorkoutDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_next_workout"
+}
+
+// Schema defines the schema for the data source.
+func (d *nextWorkoutDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Prescribes the next workout of a strategy: the exercises of its next workout template, with the sets, reps and weight of each.",
+		Attributes: map[string]schema.Attribute{
+			"strategy_id": schema.Int64Attribute{
+				Description: "The strategy_id of the strategy to prescribe the next workout of. Defaults to the strategy of workout_template_id, or else the account's active strategy.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"workout_template_id": schema.Int64Attribute{
+				Description: "The workout_template_id of the workout template to prescribe. Defaults to the strategy's workout template that follows the one last logged, in workout_template_id order, starting over after the last.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"weight_unit": schema.StringAttribute{
+				Description: "The unit, lb or kg, that weights are returned in. Defaults to the provider weight_unit.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"workout_template_name": schema.StringAttribute{
+				Description: "The name of the workout template.",
+				Computed:    true,
+			},
+			"exercises": schema.ListNestedAttribute{
+				Description: "The exercises of the workout, in the order they are performed.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"exercise_id": schema.Int64Attribute{
+							Description: "The exercise_id of the exercise.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the exercise.",
+							Computed:    true,
+						},
+						"sets": schema.Int32Attribute{
+							Description: "The number of sets to perform: the workout template's override, or else the strategy's target_sets_per_exercise.",
+							Computed:    true,
+						},
+						"reps": schema.Int32Attribute{
+							Description: "The number of reps to perform in each set: the workout template's override, or else the strategy's target_reps_per_set.",
+							Computed:    true,
+						},
+						"weight": schema.Float32Attribute{
+							Description: "The weight to lift, in weight_unit: the exercise's current weight plus the strategy's overload_rate, or its default weight when it has never been performed.",
+							Computed:    true,
+						},
+						"rest_seconds": schema.Int32Attribute{
+							Description: "The rest between sets, in seconds, or null when the workout template does not set one.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"exercise_groups": schema.ListNestedAttribute{
+				Description: "The supersets, giant sets and circuits of the workout template.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: workoutSessionGroupAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *nextWorkoutDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nextWorkoutDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	weightUnit := resolveWeightUnit(state.WeightUnit, d.client)
+	weightPrecision := resolveWeightPrecision(types.Float64Null(), d.client)
+
+	workout, err := readNextWorkout(d.client, int(state.StrategyID.ValueInt64()), int(state.WorkoutTemplateID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read BrickByBrick Next Workout",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.StrategyID = types.Int64Value(int64(workout.Strategy.ID))
+	state.WorkoutTemplateID = types.Int64Value(int64(workout.WorkoutTemplate.ID))
+	state.WorkoutTemplateName = types.StringValue(workout.WorkoutTemplate.Name)
+	state.Exercises = []nextWorkoutExerciseModel{}
+	for _, exercise := range workout.Exercises {
+		state.Exercises = append(state.Exercises, nextWorkoutExerciseModel{
+			ExerciseID:  types.Int64Value(int64(exercise.ExerciseID)),
+			Name:        types.StringValue(exercise.Name),
+			Sets:        types.Int32Value(exercise.Sets),
+			Reps:        types.Int32Value(exercise.Reps),
+			Weight:      weightPointerValue(&exercise.Weight, weightUnit, weightPrecision),
+			RestSeconds: types.Int32PointerValue(exercise.RestSeconds),
+		})
+	}
+	var groups []WorkoutSessionGroup
+	for _, group := range workout.ExerciseGroups {
+		groups = append(groups, WorkoutSessionGroup{
+			ExerciseGroupID: group.ID,
+			Name:            group.Name,
+			Type:            group.Type,
+			Rounds:          group.Rounds,
+			ExerciseIDs:     group.ExerciseIDs,
+		})
+	}
+	state.ExerciseGroups = workoutSessionGroupValues(groups)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// nextWorkout is the prescription of the next workout of a strategy, with
+// weights in lbs.
+type nextWorkout struct {
+	Strategy        *Strategy
+	WorkoutTemplate *WorkoutTemplate
+	Exercises       []nextWorkoutExercise
+	ExerciseGroups  []ExerciseGroup
+}
+
+type nextWorkoutExercise struct {
+	ExerciseID  int
+	Name        string
+	Sets        int32
+	Reps        int32
+	Weight      float32
+	RestSeconds *int32
+}
+
+// readNextWorkout prescribes the next workout. A strategyID or
+// workoutTemplateID of 0 is unset: the strategy defaults to the workout
+// template's, or else the account's active strategy, and the workout template
+// to the one that follows the strategy's last logged session.
+func readNextWorkout(client *BrickByBrickClient, strategyID int, workoutTemplateID int) (*nextWorkout, error) {
+	var workoutTemplate *WorkoutTemplate
+	if workoutTemplateID != 0 {
+		var err error
+		workoutTemplate, err = client.GetWorkoutTemplate(strconv.Itoa(workoutTemplateID))
+		if err != nil {
+			return nil, fmt.Errorf("could not read workout template ID %d: %w", workoutTemplateID, err)
+		}
+		if strategyID != 0 && strategyID != workoutTemplate.StrategyID {
+			return nil, fmt.Errorf("workout template %q (ID %d) belongs to strategy ID %d, not strategy ID %d", workoutTemplate.Name, workoutTemplate.ID, workoutTemplate.StrategyID, strategyID)
+		}
+		strategyID = workoutTemplate.StrategyID
+	}
+
+	var strategy *Strategy
+	var err error
+	if strategyID != 0 {
+		strategy, err = client.GetStrategy(strconv.Itoa(strategyID))
+		if err != nil {
+			return nil, fmt.Errorf("could not read strategy ID %d: %w", strategyID, err)
+		}
+	} else {
+		strategy, err = client.GetActiveStrategy()
+		if err != nil {
+			return nil, fmt.Errorf("could not read the active strategy: %w", err)
+		}
+	}
+
+	if workoutTemplate == nil {
+		workoutTemplate, err = nextWorkoutTemplateOf(client, strategy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	workout := nextWorkout{Strategy: strategy, WorkoutTemplate: workoutTemplate}
+	for _, templateExercise := range workoutTemplate.Exercises {
+		exercise, err := client.GetExercise(strconv.Itoa(templateExercise.ExerciseID))
+		if err != nil {
+			return nil, fmt.Errorf("could not read exercise ID %d: %w", templateExercise.ExerciseID, err)
+		}
+		workout.Exercises = append(workout.Exercises, prescribeExercise(strategy, templateExercise, exercise))
+	}
+
+	exerciseGroups, err := client.ListExerciseGroups(workoutTemplate.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the exercise groups of workout template ID %d: %w", workoutTemplate.ID, err)
+	}
+	// The API may not support the filter, so apply it again here.
+	for _, exerciseGroup := range exerciseGroups {
+		if exerciseGroup.WorkoutTemplateID == workoutTemplate.ID {
+			workout.ExerciseGroups = append(workout.ExerciseGroups, exerciseGroup)
+		}
+	}
+
+	return &workout, nil
+}
+
+// nextWorkoutTemplateOf returns the workout template of strategy that follows
+// the one of its last logged session.
+func nextWorkoutTemplateOf(client *BrickByBrickClient, strategy *Strategy) (*WorkoutTemplate, error) {
+	workoutTemplates, err := client.ListWorkoutTemplates(strategy.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the workout templates of strategy ID %d: %w", strategy.ID, err)
+	}
+	// The API may not support the filter, so apply it again here.
+	workoutTemplates = slices.DeleteFunc(workoutTemplates, func(workoutTemplate WorkoutTemplate) bool {
+		return workoutTemplate.StrategyID != strategy.ID
+	})
+	if len(workoutTemplates) == 0 {
+		return nil, fmt.Errorf("strategy %q (ID %d) has no workout templates to prescribe a workout from", strategy.DisplayName, strategy.ID)
+	}
+
+	sessions, err := client.ListAllWorkoutSessions(WorkoutSessionListOptions{StrategyID: strategy.ID})
+	if err != nil {
+		return nil, fmt.Errorf("could not list the workout sessions of strategy ID %d: %w", strategy.ID, err)
+	}
+	var lastWorkoutTemplateID *int
+	for _, session := range sessions {
+		if session.StrategyID == strategy.ID && session.WorkoutTemplateID != nil {
+			lastWorkoutTemplateID = session.WorkoutTemplateID
+		}
+	}
+
+	return nextWorkoutTemplate(workoutTemplates, lastWorkoutTemplateID), nil
+}
+
+// nextWorkoutTemplate returns the workout template that follows
+// lastWorkoutTemplateID in workout_template_id order, starting over after the
+// last. It returns the first when lastWorkoutTemplateID is nil or no longer
+// one of workoutTemplates, which must not be empty.
+func nextWorkoutTemplate(workoutTemplates []WorkoutTemplate, lastWorkoutTemplateID *int) *WorkoutTemplate {
+	sorted := slices.SortedFunc(slices.Values(workoutTemplates), func(a, b WorkoutTemplate) int {
+		return a.ID - b.ID
+	})
+	if lastWorkoutTemplateID == nil {
+		return &sorted[0]
+	}
+
+	last := slices.IndexFunc(sorted, func(workoutTemplate WorkoutTemplate) bool {
+		return workoutTemplate.ID == *lastWorkoutTemplateID
+	})
+	return &sorted[(last+1)%len(sorted)]
+}
+
+// prescribeExercise applies strategy to an exercise of a workout template. The
+// template's sets and reps override the strategy's targets, and overload_rate
+// is added to the current weight of an exercise that has been performed.
+func prescribeExercise(strategy *Strategy, templateExercise WorkoutTemplateExercise, exercise *Exercise) nextWorkoutExercise {
+	prescription := nextWorkoutExercise{
+		ExerciseID:  exercise.ID,
+		Name:        exercise.Name,
+		Sets:        strategy.TargetSetsPerExercise,
+		Reps:        strategy.TargetRepsPerSet,
+		Weight:      exercise.DefaultWeight,
+		RestSeconds: templateExercise.RestSeconds,
+	}
+	if templateExercise.Sets != nil {
+		prescription.Sets = *templateExercise.Sets
+	}
+	if templateExercise.Reps != nil {
+		prescription.Reps = *templateExercise.Reps
+	}
+	if exercise.CurrentWeight != nil {
+		prescription.Weight = *exercise.CurrentWeight + strategy.OverloadRate
+	}
+	return prescription
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestNextWorkoutTemplate(t *testing.T) {
+	workoutTemplates := []WorkoutTemplate{{ID: 30}, {ID: 10}, {ID: 20}}
+	id := func(value int) *int { return &value }
+
+	testCases := map[string]struct {
+		last *int
+		want int
+	}{
+		"never-logged":     {want: 10},
+		"follows-last":     {last: id(10), want: 20},
+		"starts-over":      {last: id(30), want: 10},
+		"deleted-template": {last: id(15), want: 10},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := nextWorkoutTemplate(workoutTemplates, testCase.last); got.ID != testCase.want {
+				t.Errorf("got workout template %d, want %d", got.ID, testCase.want)
+			}
+		})
+	}
+}
+
+func TestReadNextWorkout(t *testing.T) {
+	five, ninety := int32(5), int32(90)
+	current := float32(185)
+	strategies := map[string]Strategy{
+		"1": {ID: 1, DisplayName: "Linear", OverloadRate: 5, TargetSetsPerExercise: 3, TargetRepsPerSet: 8},
+		"2": {ID: 2, DisplayName: "Empty", OverloadRate: 2.5},
+	}
+	workoutTemplates := map[string]WorkoutTemplate{
+		"10": {ID: 10, Name: "Day A", StrategyID: 1, Exercises: []WorkoutTemplateExercise{
+			{ExerciseID: 100},
+		}},
+		"11": {ID: 11, Name: "Day B", StrategyID: 1, Exercises: []WorkoutTemplateExercise{
+			{ExerciseID: 100, Sets: &five, Reps: &five, RestSeconds: &ninety},
+			{ExerciseID: 101},
+		}},
+	}
+	exercises := map[string]Exercise{
+		"100": {ID: 100, Name: "Back squat", DefaultWeight: 135, CurrentWeight: &current},
+		"101": {ID: 101, Name: "Pull-up"},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /strategies/active", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, strategies["1"])
+	})
+	mux.HandleFunc("GET /strategies/{id}", func(w http.ResponseWriter, r *http.Request) {
+		strategy, ok := strategies[r.PathValue("id")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(t, w, strategy)
+	})
+	mux.HandleFunc("GET /workout-templates", func(w http.ResponseWriter, r *http.Request) {
+		// Ignore the strategy_id filter, as the provider filters again.
+		var all []WorkoutTemplate
+		for _, workoutTemplate := range workoutTemplates {
+			all = append(all, workoutTemplate)
+		}
+		writeJSON(t, w, all)
+	})
+	mux.HandleFunc("GET /workout-templates/{id}", func(w http.ResponseWriter, r *http.Request) {
+		workoutTemplate, ok := workoutTemplates[r.PathValue("id")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(t, w, workoutTemplate)
+	})
+	mux.HandleFunc("GET /workout-sessions", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("strategy_id") == "" {
+			t.Errorf("workout sessions listed without strategy_id")
+		}
+		day := 10
+		writeJSON(t, w, WorkoutSessionPage{Sessions: []WorkoutSession{
+			{ID: 1, Date: "2026-10-12", StrategyID: 1, WorkoutTemplateID: &day},
+			{ID: 2, Date: "2026-10-14", StrategyID: 1},
+		}, TotalCount: 2})
+	})
+	mux.HandleFunc("GET /exercises/{id}", func(w http.ResponseWriter, r *http.Request) {
+		exercise, ok := exercises[r.PathValue("id")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(t, w, exercise)
+	})
+	mux.HandleFunc("GET /exercise-groups", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, []ExerciseGroup{
+			{ID: 50, Name: "Finisher", WorkoutTemplateID: 11, Type: exerciseGroupSuperset, ExerciseIDs: []int{100, 101}, Rounds: 2},
+			{ID: 51, Name: "Other day", WorkoutTemplateID: 10, Type: exerciseGroupSuperset, ExerciseIDs: []int{100}, Rounds: 2},
+		})
+	})
+	client := newTestClient(t, mux)
+
+	t.Run("active-strategy", func(t *testing.T) {
+		workout, err := readNextWorkout(client, 0, 0)
+		if err != nil {
+			t.Fatalf("readNextWorkout: %v", err)
+		}
+
+		if workout.Strategy.ID != 1 {
+			t.Errorf("got strategy %d, want the active strategy 1", workout.Strategy.ID)
+		}
+		// The session without a workout template does not move the rotation.
+		if workout.WorkoutTemplate.ID != 11 {
+			t.Errorf("got workout template %d, want 11", workout.WorkoutTemplate.ID)
+		}
+		want := []nextWorkoutExercise{
+			{ExerciseID: 100, Name: "Back squat", Sets: 5, Reps: 5, Weight: 190, RestSeconds: &ninety},
+			{ExerciseID: 101, Name: "Pull-up", Sets: 3, Reps: 8},
+		}
+		if !slices.EqualFunc(workout.Exercises, want, func(a, b nextWorkoutExercise) bool {
+			return a.ExerciseID == b.ExerciseID && a.Name == b.Name && a.Sets == b.Sets && a.Reps == b.Reps &&
+				a.Weight == b.Weight && (a.RestSeconds == nil) == (b.RestSeconds == nil) &&
+				(a.RestSeconds == nil || *a.RestSeconds == *b.RestSeconds)
+		}) {
+			t.Errorf("got exercises %+v, want %+v", workout.Exercises, want)
+		}
+		if len(workout.ExerciseGroups) != 1 || workout.ExerciseGroups[0].ID != 50 {
+			t.Errorf("got exercise groups %+v, want only 50", workout.ExerciseGroups)
+		}
+	})
+
+	t.Run("workout-template", func(t *testing.T) {
+		workout, err := readNextWorkout(client, 0, 10)
+		if err != nil {
+			t.Fatalf("readNextWorkout: %v", err)
+		}
+
+		if workout.Strategy.ID != 1 || workout.WorkoutTemplate.ID != 10 {
+			t.Errorf("got strategy %d and workout template %d, want 1 and 10", workout.Strategy.ID, workout.WorkoutTemplate.ID)
+		}
+		if len(workout.Exercises) != 1 || workout.Exercises[0].Sets != 3 || workout.Exercises[0].Reps != 8 {
+			t.Errorf("got exercises %+v, want 3 x 8 of exercise 100", workout.Exercises)
+		}
+	})
+
+	t.Run("workout-template-of-another-strategy", func(t *testing.T) {
+		if _, err := readNextWorkout(client, 2, 10); err == nil {
+			t.Errorf("got no error for a workout template of another strategy")
+		}
+	})
+
+	t.Run("strategy-without-workout-templates", func(t *testing.T) {
+		if _, err := readNextWorkout(client, 2, 0); err == nil {
+			t.Errorf("got no error for a strategy without workout templates")
+		}
+	})
+}
+
+func TestPrescribeExercise(t *testing.T) {
+	strategy := &Strategy{OverloadRate: 2.5, TargetSetsPerExercise: 4, TargetRepsPerSet: 6}
+	current := float32(100)
+
+	testCases := map[string]struct {
+		exercise   Exercise
+		wantWeight float32
+	}{
+		"performed":       {exercise: Exercise{DefaultWeight: 45, CurrentWeight: &current}, wantWeight: 102.5},
+		"never-performed": {exercise: Exercise{DefaultWeight: 45}, wantWeight: 45},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := prescribeExercise(strategy, WorkoutTemplateExercise{}, &testCase.exercise)
+			if got.Weight != testCase.wantWeight || got.Sets != 4 || got.Reps != 6 {
+				t.Errorf("got %g for %d x %d, want %g for 4 x 6", got.Weight, got.Sets, got.Reps, testCase.wantWeight)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+const (
+	// onDestroyDelete permanently deletes the object, including its logged
+	// history.
+	onDestroyDelete = "delete"
+
+	// onDestroyArchive archives the object, hiding it from the app while
+	// keeping its logged history.
+	onDestroyArchive = "archive"
+)
+
+// onDestroyModes are the values accepted by the on_destroy attributes.
+var onDestroyModes = []string{onDestroyDelete, onDestroyArchive}
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// estimatedOneRepMax estimates the heaviest weight that could be lifted once
+// from a set of reps at weight, using the Epley formula. A single rep is its
+// own one-rep max.
+func estimatedOneRepMax(weight float32, reps int32) float32 {
+	if reps <= 1 {
+		return weight
+	}
+	return weight * (1 + float32(reps)/30)
+}
+
+// weightForReps is the inverse of estimatedOneRepMax: the weight that can be
+// lifted for reps given a one-rep max.
+func weightForReps(oneRepMax float32, reps int32) float32 {
+	if reps <= 1 {
+		return oneRepMax
+	}
+	return oneRepMax / (1 + float32(reps)/30)
+}
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &personalRecordsDataSource{}
+	_ datasource.DataSourceWithConfigure = &personalRecordsDataSource{}
+)
+
+func NewPersonalRecordsDataSource() datasource.DataSource {
+	return &personalRecordsDataSource{}
+}
+
+type personalRecordsDataSource struct {
+	client *BrickByBrickClient
+}
+
+type personalRecordsDataSourceModel struct {
+	ExerciseIDs []types.Int64          `tfsdk:"exercise_ids"`
+	From        types.String           `tfsdk:"from"`
+	To          types.String           `tfsdk:"to"`
+	WeightUnit  types.String           `tfsdk:"weight_unit"`
+	Records     []personalRecordsModel `tfsdk:"records"`
+}
+
+type personalRecordsModel struct {
+	ExerciseID         types.Int64                  `tfsdk:"exercise_id"`
+	HeaviestWeight     personalRecordSetModel       `tfsdk:"heaviest_weight"`
+	MostReps           []personalRecordSetModel     `tfsdk:"most_reps"`
+	EstimatedOneRepMax personalRecordOneRepMaxModel `tfsdk:"estimated_one_rep_max"`
+	HighestVolume      personalRecordVolumeModel    `tfsdk:"highest_volume"`
+}
+
+type personalRecordSetModel struct {
+	SessionID types.Int64   `tfsdk:"session_id"`
+	Date      types.String  `tfsdk:"date"`
+	Weight    types.Float32 `tfsdk:"weight"`
+	Reps      types.Int32   `tfsdk:"reps"`
+}
+
+type personalRecordOneRepMaxModel struct {
+	SessionID          types.Int64   `tfsdk:"session_id"`
+	Date               types.String  `tfsdk:"date"`
+	Weight             types.Float32 `tfsdk:"weight"`
+	Reps               types.Int32   `tfsdk:"reps"`
+	EstimatedOneRepMax types.Float32 `tfsdk:"estimated_one_rep_max"`
+}
+
+type personalRecordVolumeModel struct {
+	SessionID types.Int64   `tfsdk:"session_id"`
+	Date      types.String  `tfsdk:"date"`
+	Volume    types.Float32 `tfsdk:"volume"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *personalRecordsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *personalRecordsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_personal_records"
+}
+
+// personalRecordSetAttributes returns the attributes of a set that holds a
+// personal record.
+func personalRecordSetAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"session_id": schema.Int64Attribute{
+			Description: "The id of the session the set was performed in.",
+			Computed:    true,
+		},
+		"date": schema.StringAttribute{
+			Description: "The date the set was performed, in YYYY-MM-DD format.",
+			Computed:    true,
+		},
+		"weight": schema.Float32Attribute{
+			Description: "The weight of the set, in weight_unit.",
+			Computed:    true,
+		},
+		"reps": schema.Int32Attribute{
+			Description: "The number of reps performed.",
+			Computed:    true,
+		},
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *personalRecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	oneRepMaxAttributes := personalRecordSetAttributes()
+	oneRepMaxAttributes["estimated_one_rep_max"] = schema.Float32Attribute{
+		Description: "The one-rep max estimated from the set with the Epley formula, in weight_unit.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the personal records of each exercise, computed from the completed sets of logged workout sessions. When a record is matched, the earliest set keeps it.",
+		Attributes: map[string]schema.Attribute{
+			"exercise_ids": schema.SetAttribute{
+				Description: "Only list the records of these exercises.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"from": schema.StringAttribute{
+				Description: "Only count sessions on or after this date, in YYYY-MM-DD format.",
+				Optional:    true,
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"to": schema.StringAttribute{
+				Description: "Only count sessions on or before this date, in YYYY-MM-DD format.",
+				Optional:    true,
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"weight_unit": schema.StringAttribute{
+				Description: "The unit, lb or kg, that weights and volumes are returned in. Defaults to the provider weight_unit.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"records": schema.ListNestedAttribute{
+				Description: "The records of each exercise with at least one completed set, ordered by exercise_id.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"exercise_id": schema.Int64Attribute{
+							Description: "The exercise_id of the exercise.",
+							Computed:    true,
+						},
+						"heaviest_weight": schema.SingleNestedAttribute{
+							Description: "The heaviest set, with the most reps breaking ties.",
+							Computed:    true,
+							Attributes:  personalRecordSetAttributes(),
+						},
+						"most_reps": schema.ListNestedAttribute{
+							Description: "The set with the most reps at each weight lifted, lightest first.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: personalRecordSetAttributes(),
+							},
+						},
+						"estimated_one_rep_max": schema.SingleNestedAttribute{
+							Description: "The set with the highest estimated one-rep max.",
+							Computed:    true,
+							Attributes:  oneRepMaxAttributes,
+						},
+						"highest_volume": schema.SingleNestedAttribute{
+							Description: "The session with the highest volume, the sum of weight times reps across its sets of the exercise.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"session_id": schema.Int64Attribute{
+									Description: "The id of the session.",
+									Computed:    true,
+								},
+								"date": schema.StringAttribute{
+									Description: "The date of the session, in YYYY-MM-DD format.",
+									Computed:    true,
+								},
+								"volume": schema.Float32Attribute{
+									Description: "The volume of the exercise in the session, in weight_unit.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *personalRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state personalRecordsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	weightUnit := resolveWeightUnit(state.WeightUnit, d.client)
+	weightPrecision := resolveWeightPrecision(types.Float64Null(), d.client)
+
+	// Dates in YYYY-MM-DD format sort in date order.
+	if !state.From.IsNull() && !state.To.IsNull() && state.From.ValueString() > state.To.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("to"),
+			"Invalid Date Range",
+			"to must be on or after from.",
+		)
+		return
+	}
+
+	options := WorkoutSessionListOptions{
+		From: state.From.ValueString(),
+		To:   state.To.ValueString(),
+	}
+	for _, exerciseID := range state.ExerciseIDs {
+		options.ExerciseIDs = append(options.ExerciseIDs, int(exerciseID.ValueInt64()))
+	}
+
+	sessions, err := d.client.ListAllWorkoutSessions(options)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read BrickByBrick Workout Sessions",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Records = []personalRecordsModel{}
+	for _, records := range personalRecordsFromSessions(filterSessionExercises(sessions, options.ExerciseIDs)) {
+		recordsState := personalRecordsModel{
+			ExerciseID:     types.Int64Value(int64(records.ExerciseID)),
+			HeaviestWeight: records.HeaviestWeight.value(weightUnit, weightPrecision),
+			MostReps:       []personalRecordSetModel{},
+			EstimatedOneRepMax: personalRecordOneRepMaxModel{
+				SessionID:          types.Int64Value(int64(records.EstimatedOneRepMax.SessionID)),
+				Date:               types.StringValue(records.EstimatedOneRepMax.Date),
+				Weight:             weightPointerValue(&records.EstimatedOneRepMax.Weight, weightUnit, weightPrecision),
+				Reps:               types.Int32Value(records.EstimatedOneRepMax.Reps),
+				EstimatedOneRepMax: weightPointerValue(&records.EstimatedOneRepMax.EstimatedOneRepMax, weightUnit, weightPrecision),
+			},
+			HighestVolume: personalRecordVolumeModel{
+				SessionID: types.Int64Value(int64(records.HighestVolume.SessionID)),
+				Date:      types.StringValue(records.HighestVolume.Date),
+				Volume:    weightPointerValue(&records.HighestVolume.Volume, weightUnit, weightPrecision),
+			},
+		}
+		for _, record := range records.MostReps {
+			recordsState.MostReps = append(recordsState.MostReps, record.value(weightUnit, weightPrecision))
+		}
+		state.Records = append(state.Records, recordsState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// personalRecords are the records of one exercise, with weights in lbs.
+type personalRecords struct {
+	ExerciseID         int
+	HeaviestWeight     personalRecordSet
+	MostReps           []personalRecordSet
+	EstimatedOneRepMax personalRecordSet
+	HighestVolume      personalRecordVolume
+}
+
+type personalRecordSet struct {
+	SessionID          int
+	Date               string
+	Weight             float32
+	Reps               int32
+	EstimatedOneRepMax float32
+}
+
+type personalRecordVolume struct {
+	SessionID int
+	Date      string
+	Volume    float32
+}
+
+func (s personalRecordSet) value(weightUnit string, weightPrecision float64) personalRecordSetModel {
+	return personalRecordSetModel{
+		SessionID: types.Int64Value(int64(s.SessionID)),
+		Date:      types.StringValue(s.Date),
+		Weight:    weightPointerValue(&s.Weight, weightUnit, weightPrecision),
+		Reps:      types.Int32Value(s.Reps),
+	}
+}
+
+// personalRecordsFromSessions returns the records of every exercise with a
+// completed set in sessions, which must be oldest first, ordered by exercise
+// ID. A record is only broken by beating it, so the earliest set keeps a tie.
+func personalRecordsFromSessions(sessions []WorkoutSession) []personalRecords {
+	recordsByExercise := map[int]*personalRecords{}
+	// mostReps is keyed by exercise ID, then by weight in hundredths of a
+	// pound so that equal weights compare equal.
+	mostReps := map[int]map[int64]personalRecordSet{}
+
+	for _, session := range sessions {
+		volumes := map[int]float32{}
+		for _, exercise := range session.Exercises {
+			for _, set := range exercise.Sets {
+				if !set.Completed {
+					continue
+				}
+				volumes[exercise.ExerciseID] += set.Weight * float32(set.Reps)
+
+				candidate := personalRecordSet{
+					SessionID:          session.ID,
+					Date:               session.Date,
+					Weight:             set.Weight,
+					Reps:               set.Reps,
+					EstimatedOneRepMax: estimatedOneRepMax(set.Weight, set.Reps),
+				}
+				records, ok := recordsByExercise[exercise.ExerciseID]
+				if !ok {
+					records = &personalRecords{
+						ExerciseID:         exercise.ExerciseID,
+						HeaviestWeight:     candidate,
+						EstimatedOneRepMax: candidate,
+						HighestVolume:      personalRecordVolume{Volume: -1},
+					}
+					recordsByExercise[exercise.ExerciseID] = records
+					mostReps[exercise.ExerciseID] = map[int64]personalRecordSet{}
+				}
+
+				heaviest := records.HeaviestWeight
+				if candidate.Weight > heaviest.Weight || (candidate.Weight == heaviest.Weight && candidate.Reps > heaviest.Reps) {
+					records.HeaviestWeight = candidate
+				}
+				if candidate.EstimatedOneRepMax > records.EstimatedOneRepMax.EstimatedOneRepMax {
+					records.EstimatedOneRepMax = candidate
+				}
+				weight := toHundredths(set.Weight)
+				if best, ok := mostReps[exercise.ExerciseID][weight]; !ok || candidate.Reps > best.Reps {
+					mostReps[exercise.ExerciseID][weight] = candidate
+				}
+			}
+		}
+
+		for exerciseID, volume := range volumes {
+			records := recordsByExercise[exerciseID]
+			if volume > records.HighestVolume.Volume {
+				records.HighestVolume = personalRecordVolume{SessionID: session.ID, Date: session.Date, Volume: volume}
+			}
+		}
+	}
+
+	result := []personalRecords{}
+	for exerciseID, records := range recordsByExercise {
+		for _, weight := range slices.Sorted(maps.Keys(mostReps[exerciseID])) {
+			records.MostReps = append(records.MostReps, mostReps[exerciseID][weight])
+		}
+		result = append(result, *records)
+	}
+	slices.SortFunc(result, func(a, b personalRecords) int {
+		return a.ExerciseID - b.ExerciseID
+	})
+	return result
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPersonalRecordsFromSessions(t *testing.T) {
+	set := func(weight float32, reps int32) WorkoutSet {
+		return WorkoutSet{Weight: weight, Reps: reps, Completed: true}
+	}
+	sessions := []WorkoutSession{
+		{ID: 1, Date: "2026-01-01", Exercises: []WorkoutSessionExercise{
+			{ExerciseID: 2, Sets: []WorkoutSet{set(100, 10), set(100, 10), set(100, 10)}},
+			{ExerciseID: 1, Sets: []WorkoutSet{set(200, 5), set(200, 5)}},
+		}},
+		{ID: 2, Date: "2026-01-03", Exercises: []WorkoutSessionExercise{
+			// The failed set at 250 is not a record.
+			{ExerciseID: 1, Sets: []WorkoutSet{set(225, 1), set(200, 8), {Weight: 250, Reps: 1}}},
+		}},
+		{ID: 3, Date: "2026-01-05", Exercises: []WorkoutSessionExercise{
+			// Matching records does not take them.
+			{ExerciseID: 1, Sets: []WorkoutSet{set(225, 1), set(200, 8)}},
+			{ExerciseID: 3, Sets: []WorkoutSet{{Weight: 50, Reps: 4}}},
+		}},
+	}
+
+	got := personalRecordsFromSessions(sessions)
+
+	var exerciseIDs []int
+	for _, records := range got {
+		exerciseIDs = append(exerciseIDs, records.ExerciseID)
+	}
+	if !slices.Equal(exerciseIDs, []int{1, 2}) {
+		t.Fatalf("got records for exercises %v, want [1 2]", exerciseIDs)
+	}
+
+	squat := got[0]
+	if want := (personalRecordSet{SessionID: 2, Date: "2026-01-03", Weight: 225, Reps: 1, EstimatedOneRepMax: 225}); squat.HeaviestWeight != want {
+		t.Errorf("got heaviest weight %+v, want %+v", squat.HeaviestWeight, want)
+	}
+	if squat.EstimatedOneRepMax.SessionID != 2 || squat.EstimatedOneRepMax.Weight != 200 || squat.EstimatedOneRepMax.Reps != 8 {
+		t.Errorf("got estimated one-rep max %+v, want 200 x 8 in session 2", squat.EstimatedOneRepMax)
+	}
+	if want := (personalRecordVolume{SessionID: 1, Date: "2026-01-01", Volume: 2000}); squat.HighestVolume != want {
+		t.Errorf("got highest volume %+v, want %+v", squat.HighestVolume, want)
+	}
+	var mostReps []personalRecordSet
+	for _, record := range squat.MostReps {
+		mostReps = append(mostReps, personalRecordSet{SessionID: record.SessionID, Weight: record.Weight, Reps: record.Reps})
+	}
+	if want := []personalRecordSet{{SessionID: 2, Weight: 200, Reps: 8}, {SessionID: 2, Weight: 225, Reps: 1}}; !slices.Equal(mostReps, want) {
+		t.Errorf("got most reps %+v, want %+v", mostReps, want)
+	}
+
+	press := got[1]
+	if want := (personalRecordVolume{SessionID: 1, Date: "2026-01-01", Volume: 3000}); press.HighestVolume != want {
+		t.Errorf("got highest volume %+v, want %+v", press.HighestVolume, want)
+	}
+	if press.HeaviestWeight.Weight != 100 || press.HeaviestWeight.Reps != 10 {
+		t.Errorf("got heaviest weight %+v, want 100 x 10", press.HeaviestWeight)
+	}
+}
@@ -0,0 +1,550 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &programResource{}
+	_ resource.ResourceWithConfigure   = &programResource{}
+	_ resource.ResourceWithImportState = &programResource{}
+	_ resource.ResourceWithModifyPlan  = &programResource{}
+)
+
+// programPhaseAttrTypes are the attribute types of a phase block.
+var programPhaseAttrTypes = map[string]attr.Type{
+	"name":                 types.StringType,
+	"duration_weeks":       types.Int32Type,
+	"strategy_id":          types.Int64Type,
+	"workout_template_ids": types.SetType{ElemType: types.Int64Type},
+}
+
+// NewProgramResource is a helper function to simplify the provider implementation.
+func NewProgramResource() resource.Resource {
+	return &programResource{}
+}
+
+// programResource is the resource implementation.
+type programResource struct {
+	client *BrickByBrickClient
+}
+
+type programResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProgramID    types.Int64  `tfsdk:"program_id"`
+	Name         types.String `tfsdk:"name"`
+	StartDate    types.String `tfsdk:"start_date"`
+	Phases       types.List   `tfsdk:"phase"`
+	EndDate      types.String `tfsdk:"end_date"`
+	CurrentPhase types.Int32  `tfsdk:"current_phase"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+	OwnerID      types.String `tfsdk:"owner_id"`
+}
+
+type programPhaseModel struct {
+	Name               types.String `tfsdk:"name"`
+	DurationWeeks      types.Int32  `tfsdk:"duration_weeks"`
+	StrategyID         types.Int64  `tfsdk:"strategy_id"`
+	WorkoutTemplateIDs types.Set    `tfsdk:"workout_template_ids"`
+}
+
+// Metadata returns the resource type name.
+func (r *programResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_program"
+}
+
+// Create a new resource.
+func (r *programResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan programResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	newProgram := Program{
+		Name:      plan.Name.ValueString(),
+		StartDate: plan.StartDate.ValueString(),
+	}
+	newProgram.Phases, diags = programPhasesFromList(ctx, plan.Phases)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdProgram, err := r.client.CreateProgram(newProgram)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating program",
+			"Could not create program, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedEndDate, plannedCurrentPhase := plan.EndDate, plan.CurrentPhase
+	resp.Diagnostics.Append(plan.refresh(ctx, createdProgram)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.keepPlannedDates(plannedEndDate, plannedCurrentPhase)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *programResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state programResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedProgram, err := r.client.GetProgram(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading BrickByBrick Program",
+			"Could not read BrickByBrick program ID "+state.ID.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(state.refresh(ctx, refreshedProgram)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *programResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan programResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state programResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from the attributes that changed
+	changes := map[string]any{}
+	if !plan.Name.Equal(state.Name) {
+		changes["name"] = plan.Name.ValueString()
+	}
+	if !plan.StartDate.Equal(state.StartDate) {
+		changes["start_date"] = plan.StartDate.ValueString()
+	}
+	if !plan.Phases.Equal(state.Phases) {
+		phases, diags := programPhasesFromList(ctx, plan.Phases)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		changes["phases"] = phases
+	}
+
+	var program *Program
+	if len(changes) > 0 {
+		var err error
+		program, err = r.client.PatchProgram(plan.ID.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating program",
+				"Could not update program, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Only fetch the program when nothing was sent or the PATCH response did
+	// not include it.
+	if program == nil {
+		var err error
+		program, err = r.client.GetProgram(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading program",
+				"Could not read program ID "+plan.ID.String()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plannedEndDate, plannedCurrentPhase := plan.EndDate, plan.CurrentPhase
+	resp.Diagnostics.Append(plan.refresh(ctx, program)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.keepPlannedDates(plannedEndDate, plannedCurrentPhase)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *programResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state programResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteProgram(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting BrickByBrick Program",
+			"Could not delete program, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *programResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *BrickByBrickClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *programResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A multi-week training block made up of consecutive phases, each following its own strategy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the program",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"program_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The unique identifier for the program as a number.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the program.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				Required:    true,
+				Description: "The date the first phase starts, in YYYY-MM-DD format.",
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"end_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The last day of the final phase, in YYYY-MM-DD format.",
+			},
+			"current_phase": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The position, starting at 1, of the phase that today's date falls in. Null before the program starts and after it ends. Today is the date where Terraform runs.",
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the program was created, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the program was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The identifier of the account that owns the program.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"phase": schema.ListNestedBlock{
+				Description: "The phases of the program, in the order they are run. Each phase starts the day after the previous one ends.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Description: "The name of the phase, such as hypertrophy or peaking.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"duration_weeks": schema.Int32Attribute{
+							Required:    true,
+							Description: "How many weeks the phase lasts.",
+							Validators: []validator.Int32{
+								int32validator.Between(1, 52),
+							},
+						},
+						"strategy_id": schema.Int64Attribute{
+							Required:    true,
+							Description: "The strategy_id of the strategy followed during the phase.",
+						},
+						"workout_template_ids": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.Int64Type,
+							Description: "The workout_template_id of each workout template used during the phase.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *programResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan computes end_date and current_phase from the planned start_date
+// and phases.
+func (r *programResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the program is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan programResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.computeDates(ctx)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end_date"), plan.EndDate)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("current_phase"), plan.CurrentPhase)...)
+}
+
+// refresh maps a program returned by the API onto the model.
+func (m *programResourceModel) refresh(ctx context.Context, program *Program) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(strconv.Itoa(program.ID))
+	m.ProgramID = types.Int64Value(int64(program.ID))
+	m.Name = types.StringValue(program.Name)
+	m.StartDate = types.StringValue(program.StartDate)
+	m.CreatedAt = timestampValueOrNull(program.CreatedAt)
+	m.UpdatedAt = timestampValueOrNull(program.UpdatedAt)
+	m.OwnerID = stringValueOrNull(program.OwnerID)
+
+	phases := make([]programPhaseModel, 0, len(program.Phases))
+	for _, phase := range program.Phases {
+		workoutTemplateIDs := make([]int64, 0, len(phase.WorkoutTemplateIDs))
+		for _, id := range phase.WorkoutTemplateIDs {
+			workoutTemplateIDs = append(workoutTemplateIDs, int64(id))
+		}
+		workoutTemplateIDSet, setDiags := types.SetValueFrom(ctx, types.Int64Type, workoutTemplateIDs)
+		diags.Append(setDiags...)
+
+		phases = append(phases, programPhaseModel{
+			Name:               stringValueOrNull(phase.Name),
+			DurationWeeks:      types.Int32Value(phase.DurationWeeks),
+			StrategyID:         types.Int64Value(int64(phase.StrategyID)),
+			WorkoutTemplateIDs: workoutTemplateIDSet,
+		})
+	}
+	phaseList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: programPhaseAttrTypes}, phases)
+	diags.Append(listDiags...)
+	m.Phases = phaseList
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(m.computeDates(ctx)...)
+	return diags
+}
+
+// keepPlannedDates restores end_date and current_phase to the values that
+// were planned, when they were known. current_phase depends on the day it is
+// computed, so a saved plan applied on a later day would otherwise produce an
+// inconsistent result; the next Read brings it up to date.
+func (m *programResourceModel) keepPlannedDates(endDate types.String, currentPhase types.Int32) {
+	if !endDate.IsUnknown() {
+		m.EndDate = endDate
+	}
+	if !currentPhase.IsUnknown() {
+		m.CurrentPhase = currentPhase
+	}
+}
+
+// computeDates sets end_date and current_phase from start_date and the
+// phases, or marks them unknown when those are not known yet.
+func (m *programResourceModel) computeDates(ctx context.Context) diag.Diagnostics {
+	m.EndDate = types.StringUnknown()
+	m.CurrentPhase = types.Int32Unknown()
+
+	if m.StartDate.IsUnknown() || m.Phases.IsUnknown() {
+		return nil
+	}
+
+	var phases []programPhaseModel
+	diags := m.Phases.ElementsAs(ctx, &phases, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	durations := make([]int32, 0, len(phases))
+	for _, phase := range phases {
+		if phase.DurationWeeks.IsUnknown() {
+			return diags
+		}
+		durations = append(durations, phase.DurationWeeks.ValueInt32())
+	}
+
+	// start_date is validated, but is left unknown rather than failing here
+	// if the API returns something unexpected.
+	startDate, err := time.Parse(dateLayout, m.StartDate.ValueString())
+	if err != nil {
+		return diags
+	}
+	today, _ := time.Parse(dateLayout, time.Now().Format(dateLayout))
+
+	m.EndDate = types.StringValue(programEndDate(startDate, durations).Format(dateLayout))
+	if currentPhase := programCurrentPhase(startDate, durations, today); currentPhase > 0 {
+		m.CurrentPhase = types.Int32Value(int32(currentPhase))
+	} else {
+		m.CurrentPhase = types.Int32Null()
+	}
+	return diags
+}
+
+// programEndDate returns the last day of a program that starts on startDate
+// and runs phases of the given durations in weeks.
+func programEndDate(startDate time.Time, durations []int32) time.Time {
+	var weeks int
+	for _, duration := range durations {
+		weeks += int(duration)
+	}
+	return startDate.AddDate(0, 0, weeks*7-1)
+}
+
+// programCurrentPhase returns the position, starting at 1, of the phase that
+// today falls in, or 0 when today is before the start or after the end of the
+// program.
+func programCurrentPhase(startDate time.Time, durations []int32, today time.Time) int {
+	if today.Before(startDate) {
+		return 0
+	}
+
+	phaseStart := startDate
+	for i, duration := range durations {
+		phaseEnd := phaseStart.AddDate(0, 0, int(duration)*7)
+		if today.Before(phaseEnd) {
+			return i + 1
+		}
+		phaseStart = phaseEnd
+	}
+	return 0
+}
+
+// programPhasesFromList converts the phase blocks into the phases sent to the
+// API.
+func programPhasesFromList(ctx context.Context, list types.List) ([]ProgramPhase, diag.Diagnostics) {
+	var models []programPhaseModel
+	diags := list.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	phases := make([]ProgramPhase, 0, len(models))
+	for _, model := range models {
+		var workoutTemplateIDs []int64
+		diags.Append(model.WorkoutTemplateIDs.ElementsAs(ctx, &workoutTemplateIDs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		phase := ProgramPhase{
+			Name:          model.Name.ValueString(),
+			DurationWeeks: model.DurationWeeks.ValueInt32(),
+			StrategyID:    int(model.StrategyID.ValueInt64()),
+		}
+		for _, id := range workoutTemplateIDs {
+			phase.WorkoutTemplateIDs = append(phase.WorkoutTemplateIDs, int(id))
+		}
+		phases = append(phases, phase)
+	}
+	return phases, diags
+}
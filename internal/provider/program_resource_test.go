@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProgramDates(t *testing.T) {
+	startDate := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	durations := []int32{4, 4, 2}
+
+	if got, want := programEndDate(startDate, durations).Format(dateLayout), "2026-03-15"; got != want {
+		t.Errorf("expected end date %s, got %s", want, got)
+	}
+
+	testCases := map[string]struct {
+		today string
+		want  int
+	}{
+		"before-start":         {today: "2026-01-04", want: 0},
+		"first-day":            {today: "2026-01-05", want: 1},
+		"last-day-of-phase-1":  {today: "2026-02-01", want: 1},
+		"first-day-of-phase-2": {today: "2026-02-02", want: 2},
+		"last-day":             {today: "2026-03-15", want: 3},
+		"after-end":            {today: "2026-03-16", want: 0},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			today, err := time.Parse(dateLayout, testCase.today)
+			if err != nil {
+				t.Fatalf("unexpected error parsing date: %s", err)
+			}
+
+			if got := programCurrentPhase(startDate, durations, today); got != testCase.want {
+				t.Errorf("expected phase %d, got %d", testCase.want, got)
+			}
+		})
+	}
+}
@@ -7,11 +7,14 @@ import (
 	"context"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -22,7 +25,11 @@ var (
 )
 
 type brickbybrickProviderModel struct {
-	ApiKey types.String `tfsdk:"api_key"`
+	ApiKey                    types.String  `tfsdk:"api_key"`
+	WeightUnit                types.String  `tfsdk:"weight_unit"`
+	WeightPrecision           types.Float64 `tfsdk:"weight_precision"`
+	DefaultDeletionProtection types.Bool    `tfsdk:"default_deletion_protection"`
+	AdoptExisting             types.Bool    `tfsdk:"adopt_existing"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -59,6 +66,28 @@ func (p *brickbybrickProvider) Schema(_ context.Context, _ provider.SchemaReques
 				Description: "Your BrickByBrick Fitness API Key",
 				Sensitive:   true,
 			},
+			"weight_unit": schema.StringAttribute{
+				Optional:    true,
+				Description: "The unit, lb or kg, that weights are expressed in. Resources and data sources can override this. Defaults to lb.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"weight_precision": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The smallest difference, in the weight unit in use, between a configured weight and the weight stored by the API that counts as a change. Read-only weights are rounded to it. Resources can override this. Must be at least 0.01, the precision the API stores weights at, which is also the default.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(defaultWeightPrecision),
+				},
+			},
+			"default_deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Description: "The deletion_protection used by resources that do not set it. When unset, exercises are protected and strategies are not.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "The adopt_existing used by resources that do not set it. Defaults to false.",
+			},
 		},
 	}
 }
@@ -86,6 +115,24 @@ func (p *brickbybrickProvider) Configure(ctx context.Context, req provider.Confi
 		)
 	}
 
+	if config.WeightUnit.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("weight_unit"),
+			"Unknown BrickByBrick Weight Unit",
+			"The provider cannot create the BrickByBrick API client as there is an unknown configuration value for the weight unit. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.WeightPrecision.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("weight_precision"),
+			"Unknown BrickByBrick Weight Precision",
+			"The provider cannot create the BrickByBrick API client as there is an unknown configuration value for the weight precision. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -133,6 +180,20 @@ func (p *brickbybrickProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
+	if !config.WeightUnit.IsNull() {
+		client.WeightUnit = config.WeightUnit.ValueString()
+	}
+
+	if !config.WeightPrecision.IsNull() {
+		client.WeightPrecision = config.WeightPrecision.ValueFloat64()
+	}
+
+	if !config.DefaultDeletionProtection.IsNull() && !config.DefaultDeletionProtection.IsUnknown() {
+		client.DefaultDeletionProtection = config.DefaultDeletionProtection.ValueBoolPointer()
+	}
+
+	client.AdoptExisting = config.AdoptExisting.ValueBool()
+
 	// Make the HashiCups client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -144,8 +205,15 @@ func (p *brickbybrickProvider) Configure(ctx context.Context, req provider.Confi
 // DataSources defines the data sources implemented in the provider.
 func (p *brickbybrickProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewExerciseDataSource,
 		NewExercisesDataSource,
+		NewStrategyDataSource,
 		NewStrategiesDataSource,
+		NewExerciseProgressDataSource,
+		NewPersonalRecordsDataSource,
+		NewTrainingVolumeDataSource,
+		NewNextWorkoutDataSource,
+		NewWorkoutSessionsDataSource,
 	}
 }
 
@@ -154,5 +222,11 @@ func (p *brickbybrickProvider) Resources(_ context.Context) []func() resource.Re
 	return []func() resource.Resource{
 		NewExerciseResource,
 		NewStrategyResource,
+		NewWorkoutTemplateResource,
+		NewProgramResource,
+		NewScheduleResource,
+		NewExerciseGroupResource,
+		NewEquipmentProfileResource,
+		NewGoalResource,
 	}
 }
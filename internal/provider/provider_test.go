@@ -4,10 +4,21 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 )
 
@@ -32,3 +43,144 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testUpgradeResourceState upgrades rawState, written at the given schema
+// version, to the current schema of typeName. It returns the upgraded
+// attribute values, or nil alongside the diagnostics when the upgrade fails.
+func testUpgradeResourceState(t *testing.T, typeName string, version int64, rawState string) (map[string]tftypes.Value, []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error getting provider schema: %s", err)
+	}
+	schema, ok := schemaResp.ResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("resource type %q not found in provider schema", typeName)
+	}
+
+	upgradeResp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error upgrading state: %s", err)
+	}
+	for _, diagnostic := range upgradeResp.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			return nil, upgradeResp.Diagnostics
+		}
+	}
+
+	upgradedState, err := upgradeResp.UpgradedState.Unmarshal(schema.ValueType())
+	if err != nil {
+		t.Fatalf("unexpected error unmarshalling upgraded state: %s", err)
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := upgradedState.As(&attributes); err != nil {
+		t.Fatalf("unexpected error converting upgraded state: %s", err)
+	}
+
+	return attributes, upgradeResp.Diagnostics
+}
+
+// newTestClient returns a client that sends its requests to a local server
+// handled by handler.
+func newTestClient(t *testing.T, handler http.Handler) *BrickByBrickClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiKey := "test"
+	client, err := NewClient(&apiKey)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.HostURL = server.URL
+	return client
+}
+
+// writeJSON writes value as the JSON body of a response.
+func writeJSON(t *testing.T, w http.ResponseWriter, value any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		t.Errorf("encoding response: %v", err)
+	}
+}
+
+// testImportState runs ImportState of r for id against an empty state and
+// returns the id attribute it leaves in the state.
+func testImportState(t *testing.T, r resource.ResourceWithImportState, id string) (types.String, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+
+	var importedID types.String
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &importedID)...)
+	}
+	return importedID, resp.Diagnostics
+}
+
+// testCreateResource runs Create of r with plan, a model of the resource, as
+// the planned state and returns the state that Create sets.
+func testCreateResource(t *testing.T, r resource.Resource, plan any) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: null}}
+	if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+		t.Fatalf("unexpected error setting plan: %v", diags)
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: null}}
+	r.Create(ctx, req, resp)
+	return resp.State, resp.Diagnostics
+}
+
+// testReadDataSource runs Read of d with config, a model of the data source,
+// as its configuration and gets the state that Read sets into target.
+func testReadDataSource(t *testing.T, d datasource.DataSource, config any, target any) diag.Diagnostics {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	configState := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+	if diags := configState.Set(ctx, config); diags.HasError() {
+		t.Fatalf("unexpected error setting config: %v", diags)
+	}
+
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: null}}
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return resp.Diagnostics
+	}
+	resp.Diagnostics.Append(resp.State.Get(ctx, target)...)
+	return resp.Diagnostics
+}
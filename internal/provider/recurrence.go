@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	recurrenceDaily  = "DAILY"
+	recurrenceWeekly = "WEEKLY"
+)
+
+// recurrenceWeekdays maps the BYDAY values of an RRULE to weekdays.
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// recurrence is the subset of an iCalendar RRULE supported by schedules:
+// FREQ=DAILY or FREQ=WEEKLY, with optional INTERVAL and, for weekly rules,
+// BYDAY.
+type recurrence struct {
+	frequency string
+	interval  int
+	weekdays  []time.Weekday
+}
+
+// parseRecurrence parses a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH".
+// A leading "RRULE:" is allowed.
+func parseRecurrence(rule string) (recurrence, error) {
+	parsed := recurrence{interval: 1}
+
+	for _, part := range strings.Split(strings.TrimPrefix(rule, "RRULE:"), ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return recurrence{}, fmt.Errorf("%q is not a KEY=VALUE pair", part)
+		}
+
+		switch key {
+		case "FREQ":
+			if value != recurrenceDaily && value != recurrenceWeekly {
+				return recurrence{}, fmt.Errorf("FREQ must be %s or %s, got %q", recurrenceDaily, recurrenceWeekly, value)
+			}
+			parsed.frequency = value
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return recurrence{}, fmt.Errorf("INTERVAL must be a positive whole number, got %q", value)
+			}
+			parsed.interval = interval
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := recurrenceWeekdays[day]
+				if !ok {
+					return recurrence{}, fmt.Errorf("BYDAY must be a list of MO, TU, WE, TH, FR, SA or SU, got %q", day)
+				}
+				parsed.weekdays = append(parsed.weekdays, weekday)
+			}
+		default:
+			return recurrence{}, fmt.Errorf("%s is not supported; only FREQ, INTERVAL and BYDAY are", key)
+		}
+	}
+
+	if parsed.frequency == "" {
+		return recurrence{}, fmt.Errorf("FREQ is required")
+	}
+	if parsed.frequency == recurrenceDaily && len(parsed.weekdays) > 0 {
+		return recurrence{}, fmt.Errorf("BYDAY is only supported with FREQ=%s", recurrenceWeekly)
+	}
+
+	return parsed, nil
+}
+
+// occursOn reports whether the recurrence, starting on start, includes date.
+// Both must be midnight on a calendar date in the same location.
+func (r recurrence) occursOn(start time.Time, date time.Time) bool {
+	if date.Before(start) {
+		return false
+	}
+
+	switch r.frequency {
+	case recurrenceDaily:
+		return daysBetween(start, date)%r.interval == 0
+	case recurrenceWeekly:
+		weekdays := r.weekdays
+		if len(weekdays) == 0 {
+			weekdays = []time.Weekday{start.Weekday()}
+		}
+		if !slices.Contains(weekdays, date.Weekday()) {
+			return false
+		}
+		// Weeks start on Monday, as in an RRULE without WKST.
+		weekStart := start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+		return (daysBetween(weekStart, date)/7)%r.interval == 0
+	}
+	return false
+}
+
+// daysBetween returns the number of calendar days from start to end.
+func daysBetween(start time.Time, end time.Time) int {
+	startYear, startMonth, startDay := start.Date()
+	endYear, endMonth, endDay := end.Date()
+	startUTC := time.Date(startYear, startMonth, startDay, 0, 0, 0, 0, time.UTC)
+	endUTC := time.Date(endYear, endMonth, endDay, 0, 0, 0, 0, time.UTC)
+	return int(endUTC.Sub(startUTC).Hours() / 24)
+}
+
+var _ validator.String = recurrenceValidator{}
+
+// recurrenceValidator checks that a string is a recurrence rule supported by
+// parseRecurrence.
+type recurrenceValidator struct{}
+
+func (v recurrenceValidator) Description(_ context.Context) string {
+	return "value must be an RRULE using only FREQ (DAILY or WEEKLY), INTERVAL and BYDAY"
+}
+
+func (v recurrenceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v recurrenceValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseRecurrence(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Recurrence",
+			"Could not parse "+req.ConfigValue.String()+": "+err.Error()+".",
+		)
+	}
+}
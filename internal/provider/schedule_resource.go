@@ -0,0 +1,714 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &scheduleResource{}
+	_ resource.ResourceWithConfigure        = &scheduleResource{}
+	_ resource.ResourceWithImportState      = &scheduleResource{}
+	_ resource.ResourceWithConfigValidators = &scheduleResource{}
+	_ resource.ResourceWithModifyPlan       = &scheduleResource{}
+)
+
+const (
+	// scheduleDefaultTimezone is the timezone of a schedule that does not
+	// set one.
+	scheduleDefaultTimezone = "UTC"
+
+	// scheduleDefaultUpcomingCount is the number of upcoming workouts listed
+	// when upcoming_count is not set.
+	scheduleDefaultUpcomingCount = 7
+
+	// scheduleSearchDays is how far ahead upcoming workouts are looked for,
+	// so that a schedule whose dates are all excluded still terminates.
+	scheduleSearchDays = 3660
+)
+
+// scheduleWeekdays are the keys accepted by weekdays.
+var scheduleWeekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// scheduleUpcomingAttrTypes are the attribute types of an entry in upcoming.
+var scheduleUpcomingAttrTypes = map[string]attr.Type{
+	"date":                types.StringType,
+	"workout_template_id": types.Int64Type,
+}
+
+// NewScheduleResource is a helper function to simplify the provider implementation.
+func NewScheduleResource() resource.Resource {
+	return &scheduleResource{}
+}
+
+// scheduleResource is the resource implementation.
+type scheduleResource struct {
+	client *BrickByBrickClient
+}
+
+type scheduleResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ScheduleID    types.Int64  `tfsdk:"schedule_id"`
+	Name          types.String `tfsdk:"name"`
+	Timezone      types.String `tfsdk:"timezone"`
+	StartDate     types.String `tfsdk:"start_date"`
+	Weekdays      types.Map    `tfsdk:"weekdays"`
+	Recurrence    types.String `tfsdk:"recurrence"`
+	Rotation      types.List   `tfsdk:"rotation"`
+	ExcludedDates types.Set    `tfsdk:"excluded_dates"`
+	UpcomingCount types.Int32  `tfsdk:"upcoming_count"`
+	Upcoming      types.List   `tfsdk:"upcoming"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+	OwnerID       types.String `tfsdk:"owner_id"`
+}
+
+type scheduleUpcomingModel struct {
+	Date              types.String `tfsdk:"date"`
+	WorkoutTemplateID types.Int64  `tfsdk:"workout_template_id"`
+}
+
+// scheduledWorkout is a workout template planned for a date.
+type scheduledWorkout struct {
+	Date              time.Time
+	WorkoutTemplateID int
+}
+
+// Metadata returns the resource type name.
+func (r *scheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+// Create a new resource.
+func (r *scheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan scheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	newSchedule, diags := plan.schedule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdSchedule, err := r.client.CreateSchedule(*newSchedule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating schedule",
+			"Could not create schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plannedUpcoming := plan.Upcoming
+	resp.Diagnostics.Append(plan.refresh(ctx, createdSchedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.keepPlannedUpcoming(plannedUpcoming)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *scheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state scheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedSchedule, err := r.client.GetSchedule(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading BrickByBrick Schedule",
+			"Could not read BrickByBrick schedule ID "+state.ID.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	// upcoming_count is not stored by the API, so imported state starts
+	// from the default.
+	if state.UpcomingCount.IsNull() {
+		state.UpcomingCount = types.Int32Value(scheduleDefaultUpcomingCount)
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(state.refresh(ctx, refreshedSchedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *scheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan scheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state scheduleResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from the attributes that changed
+	updatedSchedule, diags := plan.schedule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes := map[string]any{}
+	if !plan.Name.Equal(state.Name) {
+		changes["name"] = updatedSchedule.Name
+	}
+	if !plan.Timezone.Equal(state.Timezone) {
+		changes["timezone"] = updatedSchedule.Timezone
+	}
+	if !plan.StartDate.Equal(state.StartDate) {
+		changes["start_date"] = plan.StartDate.ValueStringPointer()
+	}
+	if !plan.Weekdays.Equal(state.Weekdays) {
+		changes["weekdays"] = updatedSchedule.Weekdays
+	}
+	if !plan.Recurrence.Equal(state.Recurrence) {
+		changes["recurrence"] = plan.Recurrence.ValueStringPointer()
+	}
+	if !plan.Rotation.Equal(state.Rotation) {
+		changes["rotation"] = updatedSchedule.Rotation
+	}
+	if !plan.ExcludedDates.Equal(state.ExcludedDates) {
+		changes["excluded_dates"] = updatedSchedule.ExcludedDates
+	}
+
+	var schedule *Schedule
+	if len(changes) > 0 {
+		var err error
+		schedule, err = r.client.PatchSchedule(plan.ID.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating schedule",
+				"Could not update schedule, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Only fetch the schedule when nothing was sent or the PATCH response
+	// did not include it.
+	if schedule == nil {
+		var err error
+		schedule, err = r.client.GetSchedule(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading schedule",
+				"Could not read schedule ID "+plan.ID.String()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plannedUpcoming := plan.Upcoming
+	resp.Diagnostics.Append(plan.refresh(ctx, schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.keepPlannedUpcoming(plannedUpcoming)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *scheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state scheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSchedule(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting BrickByBrick Schedule",
+			"Could not delete schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *scheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *BrickByBrickClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The days an athlete trains and the workout template performed on each. Set exactly one of weekdays or recurrence.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the schedule",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schedule_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The unique identifier for the schedule as a number.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the schedule.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(scheduleDefaultTimezone),
+				Description: "The IANA time zone, such as Europe/London, that decides which date is today. Defaults to UTC.",
+				Validators: []validator.String{
+					timezoneValidator{},
+				},
+			},
+			"start_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "The first date of the schedule, in YYYY-MM-DD format. Required with recurrence, where it anchors INTERVAL and the rotation.",
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"weekdays": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "The workout_template_id performed on each training day, keyed by lowercase weekday name such as monday. Days that are not listed are rest days.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.OneOf(scheduleWeekdays...)),
+				},
+			},
+			"recurrence": schema.StringAttribute{
+				Optional:    true,
+				Description: "An RRULE-style recurrence, such as FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,WE,FR. Only FREQ (DAILY or WEEKLY), INTERVAL and BYDAY are supported. Each occurrence performs the next workout template in rotation.",
+				Validators: []validator.String{
+					recurrenceValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("start_date"),
+						path.MatchRoot("rotation"),
+					),
+				},
+			},
+			"rotation": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "The workout_template_id of each workout template performed in turn by recurrence, starting again from the first after the last.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.AlsoRequires(path.MatchRoot("recurrence")),
+				},
+			},
+			"excluded_dates": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Rest days and holidays, in YYYY-MM-DD format, on which no workout is scheduled. An excluded date does not advance the rotation.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(dateValidator{}),
+				},
+			},
+			"upcoming_count": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(scheduleDefaultUpcomingCount),
+				Description: "How many upcoming workouts to list in upcoming. Defaults to 7.",
+				Validators: []validator.Int32{
+					int32validator.Between(1, 366),
+				},
+			},
+			"upcoming": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The next upcoming_count scheduled workouts, starting today in timezone.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date of the workout, in YYYY-MM-DD format.",
+						},
+						"workout_template_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The workout_template_id of the workout template performed on the date.",
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the schedule was created, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the schedule was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The identifier of the account that owns the schedule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *scheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ConfigValidators requires exactly one of weekdays or recurrence.
+func (r *scheduleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("weekdays"),
+			path.MatchRoot("recurrence"),
+		),
+	}
+}
+
+// ModifyPlan checks that the referenced workout templates exist and computes
+// the upcoming workouts.
+func (r *scheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the schedule is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan scheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.computeUpcoming(ctx)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("upcoming"), plan.Upcoming)...)
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	// Only check the workout templates when they change, so that they are
+	// not fetched on every plan.
+	checkWeekdays, checkRotation := true, true
+	if !req.State.Raw.IsNull() {
+		var state scheduleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		checkWeekdays = !plan.Weekdays.Equal(state.Weekdays)
+		checkRotation = !plan.Rotation.Equal(state.Rotation)
+	}
+
+	if checkWeekdays {
+		for weekday, value := range plan.Weekdays.Elements() {
+			if id, ok := value.(types.Int64); ok && !id.IsNull() && !id.IsUnknown() {
+				r.checkWorkoutTemplate(id.ValueInt64(), path.Root("weekdays").AtMapKey(weekday), &resp.Diagnostics)
+			}
+		}
+	}
+	if checkRotation {
+		for i, value := range plan.Rotation.Elements() {
+			if id, ok := value.(types.Int64); ok && !id.IsNull() && !id.IsUnknown() {
+				r.checkWorkoutTemplate(id.ValueInt64(), path.Root("rotation").AtListIndex(i), &resp.Diagnostics)
+			}
+		}
+	}
+}
+
+// checkWorkoutTemplate adds an error at attributePath when the workout
+// template cannot be read.
+func (r *scheduleResource) checkWorkoutTemplate(id int64, attributePath path.Path, diags *diag.Diagnostics) {
+	if _, err := r.client.GetWorkoutTemplate(strconv.FormatInt(id, 10)); err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Workout Template Not Found",
+			fmt.Sprintf("Could not read workout template ID %d: %s", id, err.Error()),
+		)
+	}
+}
+
+// schedule converts the model into the schedule sent to the API.
+func (m *scheduleResourceModel) schedule(ctx context.Context) (*Schedule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	schedule := Schedule{
+		Name:       m.Name.ValueString(),
+		Timezone:   m.Timezone.ValueString(),
+		StartDate:  m.StartDate.ValueString(),
+		Recurrence: m.Recurrence.ValueString(),
+	}
+
+	if !m.Weekdays.IsNull() {
+		var weekdays map[string]int64
+		diags.Append(m.Weekdays.ElementsAs(ctx, &weekdays, false)...)
+		schedule.Weekdays = map[string]int{}
+		for weekday, id := range weekdays {
+			schedule.Weekdays[weekday] = int(id)
+		}
+	}
+
+	var rotation []int64
+	diags.Append(m.Rotation.ElementsAs(ctx, &rotation, false)...)
+	for _, id := range rotation {
+		schedule.Rotation = append(schedule.Rotation, int(id))
+	}
+
+	diags.Append(m.ExcludedDates.ElementsAs(ctx, &schedule.ExcludedDates, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &schedule, diags
+}
+
+// refresh maps a schedule returned by the API onto the model.
+func (m *scheduleResourceModel) refresh(ctx context.Context, schedule *Schedule) diag.Diagnostics {
+	var diags, valueDiags diag.Diagnostics
+	m.ID = types.StringValue(strconv.Itoa(schedule.ID))
+	m.ScheduleID = types.Int64Value(int64(schedule.ID))
+	m.Name = types.StringValue(schedule.Name)
+	m.Timezone = types.StringValue(schedule.Timezone)
+	m.StartDate = stringValueOrNull(schedule.StartDate)
+	m.Recurrence = stringValueOrNull(schedule.Recurrence)
+	m.CreatedAt = timestampValueOrNull(schedule.CreatedAt)
+	m.UpdatedAt = timestampValueOrNull(schedule.UpdatedAt)
+	m.OwnerID = stringValueOrNull(schedule.OwnerID)
+	if schedule.Timezone == "" {
+		m.Timezone = types.StringValue(scheduleDefaultTimezone)
+	}
+
+	m.Weekdays = types.MapNull(types.Int64Type)
+	if len(schedule.Weekdays) > 0 {
+		weekdays := map[string]int64{}
+		for weekday, id := range schedule.Weekdays {
+			weekdays[weekday] = int64(id)
+		}
+		m.Weekdays, valueDiags = types.MapValueFrom(ctx, types.Int64Type, weekdays)
+		diags.Append(valueDiags...)
+	}
+
+	m.Rotation = types.ListNull(types.Int64Type)
+	if len(schedule.Rotation) > 0 {
+		rotation := make([]int64, 0, len(schedule.Rotation))
+		for _, id := range schedule.Rotation {
+			rotation = append(rotation, int64(id))
+		}
+		m.Rotation, valueDiags = types.ListValueFrom(ctx, types.Int64Type, rotation)
+		diags.Append(valueDiags...)
+	}
+
+	m.ExcludedDates, valueDiags = stringSetValueOrNull(schedule.ExcludedDates)
+	diags.Append(valueDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(m.computeUpcoming(ctx)...)
+	return diags
+}
+
+// keepPlannedUpcoming restores upcoming to the planned list when it was
+// known. The list is computed from today's date in the schedule's timezone,
+// so applying a saved plan after midnight would otherwise change it between
+// plan and apply; the next Read refreshes it.
+func (m *scheduleResourceModel) keepPlannedUpcoming(upcoming types.List) {
+	if !upcoming.IsUnknown() {
+		m.Upcoming = upcoming
+	}
+}
+
+// computeUpcoming sets upcoming from the rest of the model, or marks it
+// unknown when the model is not fully known yet.
+func (m *scheduleResourceModel) computeUpcoming(ctx context.Context) diag.Diagnostics {
+	m.Upcoming = types.ListUnknown(types.ObjectType{AttrTypes: scheduleUpcomingAttrTypes})
+
+	for _, value := range []attr.Value{m.Timezone, m.StartDate, m.Weekdays, m.Recurrence, m.Rotation, m.ExcludedDates, m.UpcomingCount} {
+		if !isFullyKnown(ctx, value) {
+			return nil
+		}
+	}
+
+	schedule, diags := m.schedule(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The attributes are validated, but upcoming is left unknown rather
+	// than failing here if the API returns something unexpected.
+	location, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return diags
+	}
+	today, _ := time.Parse(dateLayout, time.Now().In(location).Format(dateLayout))
+
+	workouts, err := upcomingWorkouts(schedule, today, int(m.UpcomingCount.ValueInt32()))
+	if err != nil {
+		return diags
+	}
+
+	upcoming := make([]scheduleUpcomingModel, 0, len(workouts))
+	for _, workout := range workouts {
+		upcoming = append(upcoming, scheduleUpcomingModel{
+			Date:              types.StringValue(workout.Date.Format(dateLayout)),
+			WorkoutTemplateID: types.Int64Value(int64(workout.WorkoutTemplateID)),
+		})
+	}
+	var listDiags diag.Diagnostics
+	m.Upcoming, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: scheduleUpcomingAttrTypes}, upcoming)
+	diags.Append(listDiags...)
+	return diags
+}
+
+// upcomingWorkouts returns the first count workouts of schedule on or after
+// today. Dates are midnight UTC on each calendar date.
+func upcomingWorkouts(schedule *Schedule, today time.Time, count int) ([]scheduledWorkout, error) {
+	excluded := map[string]bool{}
+	for _, date := range schedule.ExcludedDates {
+		excluded[date] = true
+	}
+
+	start := today
+	if schedule.StartDate != "" {
+		var err error
+		start, err = time.Parse(dateLayout, schedule.StartDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rule recurrence
+	if schedule.Recurrence != "" {
+		var err error
+		rule, err = parseRecurrence(schedule.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+		if len(schedule.Rotation) == 0 {
+			return nil, fmt.Errorf("a recurrence requires a rotation")
+		}
+	} else if start.Before(today) {
+		// Only a recurrence needs the dates before today, to find its place
+		// in the rotation.
+		start = today
+	}
+
+	workouts := []scheduledWorkout{}
+	occurrences := 0
+	last := today.AddDate(0, 0, scheduleSearchDays)
+	for date := start; len(workouts) < count && !date.After(last); date = date.AddDate(0, 0, 1) {
+		var workoutTemplateID int
+		if schedule.Recurrence != "" {
+			if !rule.occursOn(start, date) || excluded[date.Format(dateLayout)] {
+				continue
+			}
+			workoutTemplateID = schedule.Rotation[occurrences%len(schedule.Rotation)]
+			occurrences++
+		} else {
+			id, ok := schedule.Weekdays[strings.ToLower(date.Weekday().String())]
+			if !ok || excluded[date.Format(dateLayout)] {
+				continue
+			}
+			workoutTemplateID = id
+		}
+
+		if !date.Before(today) {
+			workouts = append(workouts, scheduledWorkout{Date: date, WorkoutTemplateID: workoutTemplateID})
+		}
+	}
+	return workouts, nil
+}
+
+// isFullyKnown reports whether value and everything nested in it is known.
+func isFullyKnown(ctx context.Context, value attr.Value) bool {
+	terraformValue, err := value.ToTerraformValue(ctx)
+	return err == nil && terraformValue.IsFullyKnown()
+}
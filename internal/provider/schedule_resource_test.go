@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUpcomingWorkouts(t *testing.T) {
+	// 2026-03-02 is a Monday.
+	today := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		schedule  Schedule
+		count     int
+		want      []string
+		wantIDs   []int
+		wantError bool
+	}{
+		"weekdays": {
+			schedule: Schedule{Weekdays: map[string]int{"monday": 1, "thursday": 2}},
+			count:    4,
+			want:     []string{"2026-03-02", "2026-03-05", "2026-03-09", "2026-03-12"},
+			wantIDs:  []int{1, 2, 1, 2},
+		},
+		"weekdays-with-excluded-date": {
+			schedule: Schedule{Weekdays: map[string]int{"monday": 1}, ExcludedDates: []string{"2026-03-09"}},
+			count:    2,
+			want:     []string{"2026-03-02", "2026-03-16"},
+			wantIDs:  []int{1, 1},
+		},
+		"weekdays-future-start": {
+			schedule: Schedule{Weekdays: map[string]int{"monday": 1}, StartDate: "2026-03-10"},
+			count:    1,
+			want:     []string{"2026-03-16"},
+			wantIDs:  []int{1},
+		},
+		"daily-rotation-continues-from-start": {
+			schedule: Schedule{Recurrence: "FREQ=DAILY;INTERVAL=2", StartDate: "2026-02-27", Rotation: []int{1, 2}},
+			count:    3,
+			want:     []string{"2026-03-03", "2026-03-05", "2026-03-07"},
+			wantIDs:  []int{1, 2, 1},
+		},
+		"weekly-every-other-week": {
+			schedule: Schedule{Recurrence: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR", StartDate: "2026-03-02", Rotation: []int{1, 2, 3}},
+			count:    4,
+			want:     []string{"2026-03-02", "2026-03-06", "2026-03-16", "2026-03-20"},
+			wantIDs:  []int{1, 2, 3, 1},
+		},
+		"excluded-date-does-not-advance-rotation": {
+			schedule: Schedule{Recurrence: "FREQ=DAILY", StartDate: "2026-03-02", Rotation: []int{1, 2}, ExcludedDates: []string{"2026-03-03"}},
+			count:    3,
+			want:     []string{"2026-03-02", "2026-03-04", "2026-03-05"},
+			wantIDs:  []int{1, 2, 1},
+		},
+		"unsupported-rule": {
+			schedule:  Schedule{Recurrence: "FREQ=MONTHLY", StartDate: "2026-03-02", Rotation: []int{1}},
+			count:     1,
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			workouts, err := upcomingWorkouts(&testCase.schedule, today, testCase.count)
+			if testCase.wantError {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", workouts)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			dates, ids := []string{}, []int{}
+			for _, workout := range workouts {
+				dates = append(dates, workout.Date.Format(dateLayout))
+				ids = append(ids, workout.WorkoutTemplateID)
+			}
+			if !reflect.DeepEqual(dates, testCase.want) {
+				t.Errorf("expected dates %v, got %v", testCase.want, dates)
+			}
+			if !reflect.DeepEqual(ids, testCase.wantIDs) {
+				t.Errorf("expected workout templates %v, got %v", testCase.wantIDs, ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+const (
+	sortOrderAscending  = "asc"
+	sortOrderDescending = "desc"
+)
+
+// sortOrders are the values accepted by the sort_order attributes.
+var sortOrders = []string{sortOrderAscending, sortOrderDescending}
+
+// applySortOrder reverses the result of a comparison for descending order.
+func applySortOrder(comparison int, sortOrder string) int {
+	if sortOrder == sortOrderDescending {
+		return -comparison
+	}
+	return comparison
+}
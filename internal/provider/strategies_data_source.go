@@ -4,11 +4,20 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,6 +27,9 @@ var (
 	_ datasource.DataSourceWithConfigure = &strategiesDataSource{}
 )
 
+// strategySortKeys are the values accepted by sort_by.
+var strategySortKeys = []string{"id", "display_name", "overload_rate", "exercises_per_workout", "created_at", "updated_at"}
+
 func NewStrategiesDataSource() datasource.DataSource {
 	return &strategiesDataSource{}
 }
@@ -33,10 +45,24 @@ type strategiesModel struct {
 	TargetSetsPerExercise types.Int32   `tfsdk:"target_sets_per_exercise"`
 	TargetRepsPerSet      types.Int32   `tfsdk:"target_reps_per_set"`
 	DisplayName           types.String  `tfsdk:"display_name"`
+	Archived              types.Bool    `tfsdk:"archived"`
+	CreatedAt             types.String  `tfsdk:"created_at"`
+	UpdatedAt             types.String  `tfsdk:"updated_at"`
+	OwnerID               types.String  `tfsdk:"owner_id"`
 }
 
 type strategiesDataSourceModel struct {
-	Strategies []strategiesModel `tfsdk:"strategies"`
+	IncludeArchived        types.Bool        `tfsdk:"include_archived"`
+	WeightUnit             types.String      `tfsdk:"weight_unit"`
+	DisplayNameRegex       types.String      `tfsdk:"display_name_regex"`
+	DisplayNamePrefix      types.String      `tfsdk:"display_name_prefix"`
+	MinOverloadRate        types.Float32     `tfsdk:"min_overload_rate"`
+	MaxOverloadRate        types.Float32     `tfsdk:"max_overload_rate"`
+	MinExercisesPerWorkout types.Int32       `tfsdk:"min_exercises_per_workout"`
+	MaxExercisesPerWorkout types.Int32       `tfsdk:"max_exercises_per_workout"`
+	SortBy                 types.String      `tfsdk:"sort_by"`
+	SortOrder              types.String      `tfsdk:"sort_order"`
+	Strategies             []strategiesModel `tfsdk:"strategies"`
 }
 
 // Configure adds the provider configured client to the data source.
@@ -68,6 +94,75 @@ func (d *strategiesDataSource) Metadata(_ context.Context, req datasource.Metada
 func (d *strategiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"include_archived": schema.BoolAttribute{
+				Description: "Whether archived strategies are included in the list. Defaults to false.",
+				Optional:    true,
+			},
+			"weight_unit": schema.StringAttribute{
+				Description: "The unit, lb or kg, that weights are returned in. Defaults to the provider weight_unit.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"display_name_regex": schema.StringAttribute{
+				Description: "Only list strategies whose display name matches this regular expression, using RE2 syntax.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"display_name_prefix": schema.StringAttribute{
+				Description: "Only list strategies whose display name starts with this prefix. Matching is case sensitive.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"min_overload_rate": schema.Float32Attribute{
+				Description: "Only list strategies whose overload_rate is at least this weight, in weight_unit.",
+				Optional:    true,
+				Validators: []validator.Float32{
+					float32validator.AtLeast(0),
+				},
+			},
+			"max_overload_rate": schema.Float32Attribute{
+				Description: "Only list strategies whose overload_rate is at most this weight, in weight_unit.",
+				Optional:    true,
+				Validators: []validator.Float32{
+					float32validator.AtLeast(0),
+				},
+			},
+			"min_exercises_per_workout": schema.Int32Attribute{
+				Description: "Only list strategies with at least this many exercises per workout.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"max_exercises_per_workout": schema.Int32Attribute{
+				Description: "Only list strategies with at most this many exercises per workout.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AtLeastSumOf(path.MatchRoot("min_exercises_per_workout")),
+				},
+			},
+			"sort_by": schema.StringAttribute{
+				Description: "The attribute to sort strategies by: " + strings.Join(strategySortKeys, ", ") + ". Defaults to the order returned by the API.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(strategySortKeys...),
+				},
+			},
+			"sort_order": schema.StringAttribute{
+				Description: "Whether strategies are sorted in ascending (asc) or descending (desc) order. Requires sort_by. Defaults to asc.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortOrders...),
+					stringvalidator.AlsoRequires(path.MatchRoot("sort_by")),
+				},
+			},
 			"strategies": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "A list of your progressive overload strategies.",
@@ -82,7 +177,7 @@ func (d *strategiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							Required:    true,
 						},
 						"overload_rate": schema.Float32Attribute{
-							Description: "The amount of resistance or weight to add (in lbs) to each rep per session.",
+							Description: "The amount of resistance or weight to add (in weight_unit) to each rep per session.",
 							Required:    true,
 						},
 						"exercises_per_workout": schema.Int32Attribute{
@@ -97,6 +192,22 @@ func (d *strategiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							Description: "The goal for the number of reps that you eventually want to do in a set.",
 							Required:    true,
 						},
+						"archived": schema.BoolAttribute{
+							Description: "Whether the strategy has been archived.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the strategy was created, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "When the strategy was last updated, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"owner_id": schema.StringAttribute{
+							Description: "The identifier of the account that owns the strategy.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -107,8 +218,53 @@ func (d *strategiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 // Read refreshes the Terraform state with the latest data.
 func (d *strategiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state strategiesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	weightUnit := resolveWeightUnit(state.WeightUnit, d.client)
 
-	strategies, err := d.client.GetStrategies()
+	options := StrategyListOptions{
+		IncludeArchived:        state.IncludeArchived.ValueBool(),
+		DisplayNamePrefix:      state.DisplayNamePrefix.ValueString(),
+		MinExercisesPerWorkout: state.MinExercisesPerWorkout.ValueInt32Pointer(),
+		MaxExercisesPerWorkout: state.MaxExercisesPerWorkout.ValueInt32Pointer(),
+		SortBy:                 state.SortBy.ValueString(),
+		SortOrder:              state.SortOrder.ValueString(),
+	}
+	if !state.MinOverloadRate.IsNull() {
+		minOverloadRate := toPounds(state.MinOverloadRate.ValueFloat32(), weightUnit)
+		options.MinOverloadRate = &minOverloadRate
+	}
+	if !state.MaxOverloadRate.IsNull() {
+		maxOverloadRate := toPounds(state.MaxOverloadRate.ValueFloat32(), weightUnit)
+		options.MaxOverloadRate = &maxOverloadRate
+	}
+	if options.MinOverloadRate != nil && options.MaxOverloadRate != nil && *options.MinOverloadRate > *options.MaxOverloadRate {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_overload_rate"),
+			"Invalid Overload Rate Range",
+			"max_overload_rate must be at least min_overload_rate.",
+		)
+		return
+	}
+
+	var displayNameRegex *regexp.Regexp
+	if !state.DisplayNameRegex.IsNull() {
+		var err error
+		displayNameRegex, err = regexp.Compile(state.DisplayNameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("display_name_regex"),
+				"Invalid Display Name Regex",
+				"display_name_regex is not a valid regular expression: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	strategies, err := d.client.ListStrategies(options)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read BrickByBrick Strategies",
@@ -117,24 +273,91 @@ func (d *strategiesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	// The API may not support every filter, so apply them again here.
+	strategies = filterStrategies(strategies, options, displayNameRegex)
+	sortStrategies(strategies, options.SortBy, options.SortOrder)
+
 	// Map response body to model
 	for _, strategy := range strategies {
 		strategyState := strategiesModel{
 			ID:                    types.Int64Value(int64(strategy.ID)),
 			DisplayName:           types.StringValue(strategy.DisplayName),
-			OverloadRate:          types.Float32Value(strategy.OverloadRate),
+			OverloadRate:          types.Float32Value(fromPounds(strategy.OverloadRate, weightUnit)),
 			TargetSetsPerExercise: types.Int32Value(strategy.TargetSetsPerExercise),
 			TargetRepsPerSet:      types.Int32Value(strategy.TargetRepsPerSet),
 			ExercisesPerWorkout:   types.Int32Value(strategy.ExercisesPerWorkout),
+			Archived:              types.BoolValue(strategy.Archived),
+			CreatedAt:             timestampValueOrNull(strategy.CreatedAt),
+			UpdatedAt:             timestampValueOrNull(strategy.UpdatedAt),
+			OwnerID:               stringValueOrNull(strategy.OwnerID),
 		}
 
 		state.Strategies = append(state.Strategies, strategyState)
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+// filterStrategies returns the strategies that match every filter in options
+// and, when it is not nil, displayNameRegex.
+func filterStrategies(strategies []Strategy, options StrategyListOptions, displayNameRegex *regexp.Regexp) []Strategy {
+	filtered := []Strategy{}
+	for _, strategy := range strategies {
+		if !options.IncludeArchived && strategy.Archived {
+			continue
+		}
+		if !strings.HasPrefix(strategy.DisplayName, options.DisplayNamePrefix) {
+			continue
+		}
+		if displayNameRegex != nil && !displayNameRegex.MatchString(strategy.DisplayName) {
+			continue
+		}
+		overloadRate := roundToPrecision(strategy.OverloadRate, defaultWeightPrecision)
+		if options.MinOverloadRate != nil && overloadRate < roundToPrecision(*options.MinOverloadRate, defaultWeightPrecision) {
+			continue
+		}
+		if options.MaxOverloadRate != nil && overloadRate > roundToPrecision(*options.MaxOverloadRate, defaultWeightPrecision) {
+			continue
+		}
+		if options.MinExercisesPerWorkout != nil && strategy.ExercisesPerWorkout < *options.MinExercisesPerWorkout {
+			continue
+		}
+		if options.MaxExercisesPerWorkout != nil && strategy.ExercisesPerWorkout > *options.MaxExercisesPerWorkout {
+			continue
+		}
+		filtered = append(filtered, strategy)
+	}
+	return filtered
+}
+
+// sortStrategies sorts strategies in place by one of strategySortKeys. An
+// empty sortBy keeps the order returned by the API.
+func sortStrategies(strategies []Strategy, sortBy string, sortOrder string) {
+	if sortBy == "" {
+		return
+	}
+
+	slices.SortStableFunc(strategies, func(a, b Strategy) int {
+		var comparison int
+		switch sortBy {
+		case "id":
+			comparison = cmp.Compare(a.ID, b.ID)
+		case "display_name":
+			comparison = strings.Compare(a.DisplayName, b.DisplayName)
+		case "overload_rate":
+			comparison = cmp.Compare(a.OverloadRate, b.OverloadRate)
+		case "exercises_per_workout":
+			comparison = cmp.Compare(a.ExercisesPerWorkout, b.ExercisesPerWorkout)
+		case "created_at":
+			comparison = strings.Compare(timestampValueOrNull(a.CreatedAt).ValueString(), timestampValueOrNull(b.CreatedAt).ValueString())
+		case "updated_at":
+			comparison = strings.Compare(timestampValueOrNull(a.UpdatedAt).ValueString(), timestampValueOrNull(b.UpdatedAt).ValueString())
+		}
+		return applySortOrder(comparison, sortOrder)
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestFilterAndSortStrategies(t *testing.T) {
+	strategies := []Strategy{
+		{ID: 1, DisplayName: "5x5", OverloadRate: 5, ExercisesPerWorkout: 3},
+		{ID: 2, DisplayName: "Starting Strength", OverloadRate: 10, ExercisesPerWorkout: 3},
+		{ID: 3, DisplayName: "Hypertrophy", OverloadRate: 2.5, ExercisesPerWorkout: 6},
+		{ID: 4, DisplayName: "Old 5x5", OverloadRate: 5, ExercisesPerWorkout: 3, Archived: true},
+		// Stored as the pounds equivalent of 1.25 kg.
+		{ID: 5, DisplayName: "Metric", OverloadRate: 2.7557864, ExercisesPerWorkout: 4},
+	}
+	pounds := func(value float32) *float32 { return &value }
+	count := func(value int32) *int32 { return &value }
+
+	testCases := map[string]struct {
+		options          StrategyListOptions
+		displayNameRegex *regexp.Regexp
+		wantIDs          []int
+	}{
+		"no-filters": {
+			wantIDs: []int{1, 2, 3, 5},
+		},
+		"include-archived": {
+			options: StrategyListOptions{IncludeArchived: true},
+			wantIDs: []int{1, 2, 3, 4, 5},
+		},
+		"display-name-prefix-and-regex": {
+			options:          StrategyListOptions{DisplayNamePrefix: "S", IncludeArchived: true},
+			displayNameRegex: regexp.MustCompile(`(?i)strength$`),
+			wantIDs:          []int{2},
+		},
+		"overload-rate-range": {
+			options: StrategyListOptions{MinOverloadRate: pounds(2.5), MaxOverloadRate: pounds(5)},
+			wantIDs: []int{1, 3, 5},
+		},
+		"overload-rate-bound-is-rounded": {
+			options: StrategyListOptions{MaxOverloadRate: pounds(2.7557)},
+			wantIDs: []int{3, 5},
+		},
+		"exercises-per-workout-range": {
+			options: StrategyListOptions{MinExercisesPerWorkout: count(4), MaxExercisesPerWorkout: count(6)},
+			wantIDs: []int{3, 5},
+		},
+		"sort-by-overload-rate-descending": {
+			options: StrategyListOptions{SortBy: "overload_rate", SortOrder: sortOrderDescending},
+			wantIDs: []int{2, 1, 5, 3},
+		},
+		"sort-by-exercises-per-workout-is-stable": {
+			options: StrategyListOptions{SortBy: "exercises_per_workout"},
+			wantIDs: []int{1, 2, 5, 3},
+		},
+		"sort-by-display-name": {
+			options: StrategyListOptions{SortBy: "display_name"},
+			wantIDs: []int{1, 3, 5, 2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := filterStrategies(slices.Clone(strategies), testCase.options, testCase.displayNameRegex)
+			sortStrategies(got, testCase.options.SortBy, testCase.options.SortOrder)
+
+			gotIDs := []int{}
+			for _, strategy := range got {
+				gotIDs = append(gotIDs, strategy.ID)
+			}
+			if !slices.Equal(gotIDs, testCase.wantIDs) {
+				t.Errorf("expected strategies %v, got %v", testCase.wantIDs, gotIDs)
+			}
+		})
+	}
+}
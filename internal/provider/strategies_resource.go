@@ -7,26 +7,48 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &strategyResource{}
-	_ resource.ResourceWithConfigure   = &strategyResource{}
-	_ resource.ResourceWithImportState = &strategyResource{}
+	_ resource.Resource                     = &strategyResource{}
+	_ resource.ResourceWithConfigure        = &strategyResource{}
+	_ resource.ResourceWithImportState      = &strategyResource{}
+	_ resource.ResourceWithUpgradeState     = &strategyResource{}
+	_ resource.ResourceWithConfigValidators = &strategyResource{}
+	_ resource.ResourceWithModifyPlan       = &strategyResource{}
 )
 
+// strategyImportDisplayNamePrefix marks an import ID that identifies a
+// strategy by display name rather than by numeric ID.
+const strategyImportDisplayNamePrefix = "display_name:"
+
+// strategyDeletionProtectionDefault is the deletion_protection of a strategy
+// when neither the resource nor the provider sets it.
+const strategyDeletionProtectionDefault = false
+
+// defaultMaxOverloadFraction is the largest fraction of an exercise's default
+// weight that overload_rate may be before a warning is raised, when
+// max_overload_fraction is not set.
+const defaultMaxOverloadFraction = 0.5
+
 // NewStrategyResource is a helper function to simplify the provider implementation.
 func NewStrategyResource() resource.Resource {
 	return &strategyResource{}
@@ -39,11 +61,23 @@ type strategyResource struct {
 
 type strategyResourceModel struct {
 	ID                    types.String  `tfsdk:"id"`
+	StrategyID            types.Int64   `tfsdk:"strategy_id"`
 	DisplayName           types.String  `tfsdk:"display_name"`
-	OverloadRate          types.Float32 `tfsdk:"overload_rate"`
+	OverloadRate          WeightValue   `tfsdk:"overload_rate"`
 	ExercisesPerWorkout   types.Int32   `tfsdk:"exercises_per_workout"`
 	TargetSetsPerExercise types.Int32   `tfsdk:"target_sets_per_exercise"`
 	TargetRepsPerSet      types.Int32   `tfsdk:"target_reps_per_set"`
+	WeightUnit            types.String  `tfsdk:"weight_unit"`
+	WeightPrecision       types.Float64 `tfsdk:"weight_precision"`
+	EquipmentProfileID    types.Int64   `tfsdk:"equipment_profile_id"`
+	LoadableOverloadRate  types.Float32 `tfsdk:"loadable_overload_rate"`
+	MaxOverloadFraction   types.Float32 `tfsdk:"max_overload_fraction"`
+	OnDestroy             types.String  `tfsdk:"on_destroy"`
+	DeletionProtection    types.Bool    `tfsdk:"deletion_protection"`
+	AdoptExisting         types.Bool    `tfsdk:"adopt_existing"`
+	CreatedAt             types.String  `tfsdk:"created_at"`
+	UpdatedAt             types.String  `tfsdk:"updated_at"`
+	OwnerID               types.String  `tfsdk:"owner_id"`
 }
 
 // Metadata returns the resource type name.
@@ -62,32 +96,41 @@ func (r *strategyResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Generate API request body from plan
+	weightUnit := resolveWeightUnit(plan.WeightUnit, r.client)
+	weightPrecision := resolveWeightPrecision(plan.WeightPrecision, r.client)
 
 	newStrategy := CreateStrategyPayload{
 		DisplayName:           plan.DisplayName.ValueString(),
-		OverloadRate:          *plan.OverloadRate.ValueFloat32Pointer(),
+		OverloadRate:          toPounds(plan.loadedOverloadRate().ValueFloat32(), weightUnit),
 		TargetRepsPerSet:      *plan.TargetRepsPerSet.ValueInt32Pointer(),
 		TargetSetsPerExercise: *plan.TargetSetsPerExercise.ValueInt32Pointer(),
 		ExercisesPerWorkout:   *plan.ExercisesPerWorkout.ValueInt32Pointer(),
+		EquipmentProfileID:    intPointerValue(plan.EquipmentProfileID),
+	}
+
+	var createdStrategy *Strategy
+	if resolveAdoptExisting(plan.AdoptExisting, r.client) {
+		createdStrategy = r.adopt(ctx, newStrategy, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Create new order
-	createdStrategy, err := r.client.CreateStrategy(newStrategy)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating strategy",
-			"Could not create strategy, unexpected error: "+err.Error(),
-		)
-		return
+	if createdStrategy == nil {
+		var err error
+		createdStrategy, err = r.client.CreateStrategy(newStrategy)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating strategy",
+				"Could not create strategy, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan.ID = types.StringValue(strconv.Itoa(createdStrategy.ID))
-	plan.OverloadRate = types.Float32Value(createdStrategy.OverloadRate)
-	plan.DisplayName = types.StringValue(createdStrategy.DisplayName)
-	plan.ExercisesPerWorkout = types.Int32Value(createdStrategy.ExercisesPerWorkout)
-	plan.TargetRepsPerSet = types.Int32Value(createdStrategy.TargetRepsPerSet)
-	plan.TargetSetsPerExercise = types.Int32Value(createdStrategy.TargetSetsPerExercise)
+	plan.refresh(createdStrategy, weightUnit, weightPrecision)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -97,6 +140,57 @@ func (r *strategyResource) Create(ctx context.Context, req resource.CreateReques
 	}
 }
 
+// adopt takes over the existing strategy with the same display name as
+// strategy and updates it to match the plan. It returns nil when no such
+// strategy exists.
+func (r *strategyResource) adopt(ctx context.Context, strategy CreateStrategyPayload, diags *diag.Diagnostics) *Strategy {
+	matches, err := r.client.FindStrategiesByDisplayName(strategy.DisplayName)
+	if err != nil {
+		diags.AddError(
+			"Error creating strategy",
+			"Could not look up existing strategies to adopt, unexpected error: "+err.Error(),
+		)
+		return nil
+	}
+
+	if len(matches) == 0 {
+		return nil
+	}
+	if len(matches) > 1 {
+		diags.AddAttributeError(
+			path.Root("adopt_existing"),
+			"Ambiguous Strategy To Adopt",
+			fmt.Sprintf("%d strategies have the display name %q (IDs %s), so none of them was adopted. "+
+				"Import the intended strategy by its numeric ID instead.",
+				len(matches), strategy.DisplayName, joinStrategyIDs(matches)),
+		)
+		return nil
+	}
+
+	strategyID := strconv.Itoa(matches[0].ID)
+	tflog.Info(ctx, "Adopting existing BrickByBrick strategy", map[string]any{"id": strategyID})
+
+	_, err = r.client.UpdateStrategy(strategyID, strategy)
+	if err != nil {
+		diags.AddError(
+			"Error adopting strategy",
+			"Could not update existing strategy ID "+strategyID+": "+err.Error(),
+		)
+		return nil
+	}
+
+	adopted, err := r.client.GetStrategy(strategyID)
+	if err != nil {
+		diags.AddError(
+			"Error adopting strategy",
+			"Could not read strategy ID "+strategyID+": "+err.Error(),
+		)
+		return nil
+	}
+
+	return adopted
+}
+
 // Read refreshes the Terraform state with the latest data.
 // Read resource information.
 func (r *strategyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -119,11 +213,16 @@ func (r *strategyResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Overwrite items with refreshed state
-	state.DisplayName = types.StringValue(refreshedStrategy.DisplayName)
-	state.OverloadRate = types.Float32Value(refreshedStrategy.OverloadRate)
-	state.ExercisesPerWorkout = types.Int32Value(refreshedStrategy.ExercisesPerWorkout)
-	state.TargetRepsPerSet = types.Int32Value(refreshedStrategy.TargetRepsPerSet)
-	state.TargetRepsPerSet = types.Int32Value(refreshedStrategy.TargetRepsPerSet)
+	state.refresh(refreshedStrategy, resolveWeightUnit(state.WeightUnit, r.client), resolveWeightPrecision(state.WeightPrecision, r.client))
+
+	// deletion_protection and on_destroy are not stored by the API, so
+	// imported or upgraded state starts from the defaults.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(resolveDeletionProtection(r.client, strategyDeletionProtectionDefault))
+	}
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue(onDestroyDelete)
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -143,41 +242,46 @@ func (r *strategyResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// Generate API request body from plan
-	updatedStrategy := CreateStrategyPayload{
-		DisplayName:           plan.DisplayName.ValueString(),
-		OverloadRate:          plan.OverloadRate.ValueFloat32(),
-		ExercisesPerWorkout:   plan.ExercisesPerWorkout.ValueInt32(),
-		TargetRepsPerSet:      plan.TargetRepsPerSet.ValueInt32(),
-		TargetSetsPerExercise: plan.TargetSetsPerExercise.ValueInt32(),
+	var state strategyResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Update existing order
-	_, err := r.client.UpdateStrategy(plan.ID.ValueString(), updatedStrategy)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating strategy",
-			"Could not update strategy, unexpected error: "+err.Error(),
-		)
-		return
+	// Generate API request body from the attributes that changed
+	weightUnit := resolveWeightUnit(plan.WeightUnit, r.client)
+	weightPrecision := resolveWeightPrecision(plan.WeightPrecision, r.client)
+	changes := strategyPatch(plan, state, weightUnit, resolveWeightUnit(state.WeightUnit, r.client), weightPrecision)
+
+	var strategy *Strategy
+	if len(changes) > 0 {
+		var err error
+		strategy, err = r.client.PatchStrategy(plan.ID.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating strategy",
+				"Could not update strategy, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
-	// Fetch updated items from GetOrder as UpdateOrder items are not
-	// populated.
-	strategy, err := r.client.GetStrategy(plan.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading strategy",
-			"Could not read strategy ID "+plan.ID.String()+": "+err.Error(),
-		)
-		return
+	// Only fetch the strategy when nothing was sent or the PATCH response
+	// did not include it.
+	if strategy == nil {
+		var err error
+		strategy, err = r.client.GetStrategy(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading strategy",
+				"Could not read strategy ID "+plan.ID.String()+": "+err.Error(),
+			)
+			return
+		}
 	}
 
-	plan.DisplayName = types.StringValue(strategy.DisplayName)
-	plan.OverloadRate = types.Float32Value(strategy.OverloadRate)
-	plan.ExercisesPerWorkout = types.Int32Value(strategy.ExercisesPerWorkout)
-	plan.TargetRepsPerSet = types.Int32Value(strategy.TargetRepsPerSet)
-	plan.TargetSetsPerExercise = types.Int32Value(strategy.TargetSetsPerExercise)
+	plan.refresh(strategy, weightUnit, weightPrecision)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -186,6 +290,42 @@ func (r *strategyResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 }
 
+// strategyPatch returns the API attributes that differ between plan and state.
+// Weights only differ by more than weightPrecision.
+func strategyPatch(plan, state strategyResourceModel, planWeightUnit, stateWeightUnit string, weightPrecision float64) map[string]any {
+	changes := map[string]any{}
+
+	if !plan.DisplayName.Equal(state.DisplayName) {
+		changes["display_name"] = plan.DisplayName.ValueString()
+	}
+	if weightChanged(plan.loadedOverloadRate(), planWeightUnit, state.loadedOverloadRate(), stateWeightUnit, weightPrecision) {
+		changes["overload_rate"] = toPounds(plan.loadedOverloadRate().ValueFloat32(), planWeightUnit)
+	}
+	if !plan.ExercisesPerWorkout.Equal(state.ExercisesPerWorkout) {
+		changes["exercises_per_workout"] = plan.ExercisesPerWorkout.ValueInt32()
+	}
+	if !plan.TargetSetsPerExercise.Equal(state.TargetSetsPerExercise) {
+		changes["target_sets_per_exercise"] = plan.TargetSetsPerExercise.ValueInt32()
+	}
+	if !plan.TargetRepsPerSet.Equal(state.TargetRepsPerSet) {
+		changes["target_reps_per_set"] = plan.TargetRepsPerSet.ValueInt32()
+	}
+	if !plan.EquipmentProfileID.Equal(state.EquipmentProfileID) {
+		changes["equipment_profile_id"] = plan.EquipmentProfileID.ValueInt64Pointer()
+	}
+
+	return changes
+}
+
+// loadedOverloadRate returns the overload rate that is sent to the API:
+// loadable_overload_rate once it is known, and overload_rate otherwise.
+func (m *strategyResourceModel) loadedOverloadRate() WeightValue {
+	if m.LoadableOverloadRate.IsNull() || m.LoadableOverloadRate.IsUnknown() {
+		return m.OverloadRate
+	}
+	return NewWeightValue(m.LoadableOverloadRate.ValueFloat32(), defaultWeightPrecision)
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *strategyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
@@ -196,6 +336,24 @@ func (r *strategyResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// Archiving keeps the logged history, so it is allowed even when
+	// deletion protection is enabled.
+	if state.OnDestroy.ValueString() == onDestroyArchive {
+		err := r.client.ArchiveStrategy(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Archiving BrickByBrick Strategy",
+				"Could not archive strategy, unexpected error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError("Strategy", state.DisplayName.String()))
+		return
+	}
+
 	// Delete existing strategy
 	err := r.client.DeleteStrategy(state.ID.ValueString())
 	if err != nil {
@@ -232,6 +390,7 @@ func (r *strategyResource) Configure(_ context.Context, req resource.ConfigureRe
 // Schema defines the schema for the resource.
 func (r *strategyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -240,6 +399,13 @@ func (r *strategyResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"strategy_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The unique identifier for the strategy as a number, matching the id returned by the brickbybrick_strategies data source.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"display_name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the strategy",
@@ -249,7 +415,8 @@ func (r *strategyResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"overload_rate": schema.Float32Attribute{
-				Description: "The amount of resistance or weight to add (in lbs) to each rep per session.",
+				Description: "The amount of resistance or weight to add (in the configured weight_unit) to each rep per session.",
+				CustomType:  WeightType{Precision: defaultWeightPrecision},
 				Required:    true,
 				Validators: []validator.Float32{
 					float32validator.AtLeast(0),
@@ -280,11 +447,391 @@ func (r *strategyResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					int32validator.AtMost(100000),
 				},
 			},
+			"weight_unit": schema.StringAttribute{
+				Description: "The unit, lb or kg, that overload_rate is expressed in. Defaults to the provider weight_unit.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"weight_precision": schema.Float64Attribute{
+				Description: "The smallest difference, in weight_unit, between overload_rate and the rate stored by the API that counts as a change. Must be at least 0.01. Defaults to the provider weight_precision.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(defaultWeightPrecision),
+				},
+			},
+			"max_overload_fraction": schema.Float32Attribute{
+				Description: "The largest fraction of the default weight of an exercise in the strategy's workout templates that overload_rate may be before a warning is shown during plan. Defaults to 0.5.",
+				Optional:    true,
+				Validators: []validator.Float32{
+					float32validator.Between(0, 1),
+				},
+			},
+			"equipment_profile_id": schema.Int64Attribute{
+				Description: "The equipment_profile_id of the equipment profile the strategy is performed with. overload_rate must then be a multiple of the profile's increment, or is snapped to one, according to the profile's on_unloadable_weight.",
+				Optional:    true,
+			},
+			"loadable_overload_rate": schema.Float32Attribute{
+				Description: "The overload rate that is actually applied, in the configured weight_unit. This is overload_rate snapped to the equipment profile's increment when it cannot be loaded, and overload_rate otherwise.",
+				Computed:    true,
+			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What happens to the strategy in BrickByBrick when it is destroyed: delete removes it along with its logged history, archive hides it while keeping the history. Defaults to delete.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(onDestroyDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyModes...),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the strategy was created, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the strategy was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The identifier of the account that owns the strategy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "Whether creating this resource takes over an existing strategy with the same display name instead of creating a duplicate. Defaults to the provider adopt_existing, or false.",
+				Optional:    true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether Terraform is prevented from deleting the strategy. Defaults to the provider default_deletion_protection, or false.",
+				Optional:    true,
+				Computed:    true,
+			},
 		},
 	}
 }
 
+// ImportState imports a strategy by its numeric identifier, or by its exact
+// display name when the import ID has the form "display_name:<strategy name>".
 func (r *strategyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	displayName, ok := strings.CutPrefix(req.ID, strategyImportDisplayNamePrefix)
+	if !ok {
+		// Retrieve import ID and save to id attribute
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	matches, err := r.client.FindStrategiesByDisplayName(displayName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing BrickByBrick Strategy",
+			"Could not list strategies to resolve display name "+strconv.Quote(displayName)+": "+err.Error(),
+		)
+		return
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Strategy Not Found",
+			"No strategy has the display name "+strconv.Quote(displayName)+". Display names are matched exactly, including case.",
+		)
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(matches[0].ID))...)
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous Strategy Display Name",
+			fmt.Sprintf("%d strategies have the display name %q (IDs %s). Import one of them by its numeric ID instead.",
+				len(matches), displayName, joinStrategyIDs(matches)),
+		)
+	}
+}
+
+// ConfigValidators returns the validators that check combinations of
+// attributes.
+func (r *strategyResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		strategyWorkoutVolumeValidator{},
+	}
+}
+
+// ModifyPlan defaults deletion_protection and warns when overload_rate is a
+// large fraction of the default weight of any exercise in the strategy's
+// workout templates, as the first progression would then be an implausibly
+// big jump.
+func (r *strategyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the strategy is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planDeletionProtection(ctx, req, resp, r.client, strategyDeletionProtectionDefault)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.planLoadableOverloadRate(ctx, req, resp)
+
+	// The overload check needs the API, which is unavailable until the
+	// provider has been configured.
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	var plan strategyResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.OverloadRate.IsUnknown() || plan.WeightUnit.IsUnknown() || plan.MaxOverloadFraction.IsUnknown() {
+		return
+	}
+
+	// Exercises are only referenced through the strategy's workout templates,
+	// which cannot exist before the strategy does.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state strategyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only check when the relevant attributes change, so that the warning
+	// is not repeated on every plan.
+	if state.OverloadRate.Equal(plan.OverloadRate) && state.WeightUnit.Equal(plan.WeightUnit) && state.MaxOverloadFraction.Equal(plan.MaxOverloadFraction) {
+		return
+	}
+
+	maxOverloadFraction := float32(defaultMaxOverloadFraction)
+	if !plan.MaxOverloadFraction.IsNull() {
+		maxOverloadFraction = plan.MaxOverloadFraction.ValueFloat32()
+	}
+
+	strategyID := int(state.StrategyID.ValueInt64())
+	workoutTemplates, err := r.client.ListWorkoutTemplates(strategyID)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check BrickByBrick Strategy Overload Rate",
+			"Could not list the strategy's workout templates to compare against overload_rate: "+err.Error(),
+		)
+		return
+	}
+
+	exercises, err := r.client.GetExercises(false)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check BrickByBrick Strategy Overload Rate",
+			"Could not list exercises to compare against overload_rate: "+err.Error(),
+		)
+		return
+	}
+
+	weightUnit := resolveWeightUnit(plan.WeightUnit, r.client)
+	overloadRate := toPounds(plan.OverloadRate.ValueFloat32(), weightUnit)
+
+	var heavyExercises []string
+	for _, exercise := range exercisesOverloadedBy(exercises, workoutTemplates, strategyID, overloadRate, maxOverloadFraction) {
+		heavyExercises = append(heavyExercises, fmt.Sprintf("%s (%g %s)", exercise.Name, fromPounds(exercise.DefaultWeight, weightUnit), weightUnit))
+	}
+
+	if len(heavyExercises) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("overload_rate"),
+			"Overload Rate Is Large Compared To Exercise Weights",
+			fmt.Sprintf("An overload_rate of %g %s is more than %g of the default weight of these exercises: %s. "+
+				"Lower overload_rate or raise max_overload_fraction if this is intended.",
+				plan.OverloadRate.ValueFloat32(), weightUnit, maxOverloadFraction, strings.Join(heavyExercises, ", ")),
+		)
+	}
+}
+
+// exercisesOverloadedBy returns the exercises in the workout templates of
+// strategyID whose default weight overloadRate is more than
+// maxOverloadFraction of. Weights are in lbs.
+func exercisesOverloadedBy(exercises []Exercise, workoutTemplates []WorkoutTemplate, strategyID int, overloadRate, maxOverloadFraction float32) []Exercise {
+	referenced := map[int]bool{}
+	for _, workoutTemplate := range workoutTemplates {
+		// The API may not support the filter, so apply it again here.
+		if workoutTemplate.StrategyID != strategyID {
+			continue
+		}
+		for _, exercise := range workoutTemplate.Exercises {
+			referenced[exercise.ExerciseID] = true
+		}
+	}
+
+	var overloaded []Exercise
+	for _, exercise := range exercises {
+		if referenced[exercise.ID] && exercise.DefaultWeight > 0 && overloadRate > maxOverloadFraction*exercise.DefaultWeight {
+			overloaded = append(overloaded, exercise)
+		}
+	}
+	return overloaded
+}
+
+// planLoadableOverloadRate sets loadable_overload_rate to the planned
+// overload_rate, checked against the equipment profile when one is
+// referenced. It is left unknown until the values it depends on are known.
+func (r *strategyResource) planLoadableOverloadRate(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan strategyResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.OverloadRate.IsUnknown() || plan.EquipmentProfileID.IsUnknown() || plan.WeightUnit.IsUnknown() || plan.WeightPrecision.IsUnknown() {
+		return
+	}
+
+	weightUnit := resolveWeightUnit(plan.WeightUnit, r.client)
+	weightPrecision := resolveWeightPrecision(plan.WeightPrecision, r.client)
+	loadable := roundToPrecision(plan.OverloadRate.ValueFloat32(), defaultWeightPrecision)
+
+	if !plan.EquipmentProfileID.IsNull() {
+		// Only check when the relevant attributes change, so that the
+		// equipment profile is not fetched on every plan.
+		if !req.State.Raw.IsNull() {
+			var state strategyResourceModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if !state.LoadableOverloadRate.IsNull() && plan.EquipmentProfileID.Equal(state.EquipmentProfileID) &&
+				plan.WeightUnit.Equal(state.WeightUnit) && !weightChanged(plan.OverloadRate, weightUnit, state.OverloadRate, weightUnit, weightPrecision) {
+				plan.LoadableOverloadRate = state.LoadableOverloadRate
+				resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+				return
+			}
+		}
+
+		// The equipment profile cannot be read before the provider has been
+		// configured.
+		if r.client == nil {
+			return
+		}
+
+		equipmentProfile, err := r.client.GetEquipmentProfile(strconv.FormatInt(plan.EquipmentProfileID.ValueInt64(), 10))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("equipment_profile_id"),
+				"Unable to Read BrickByBrick Equipment Profile",
+				"Could not read equipment profile ID "+plan.EquipmentProfileID.String()+" to check overload_rate: "+err.Error(),
+			)
+			return
+		}
+
+		var diags diag.Diagnostics
+		loadable, diags = loadableOverloadRate(equipmentProfile, loadable, weightUnit)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.LoadableOverloadRate = types.Float32Value(loadable)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// strategyResourceModelV0 is the state of a strategy before strategy_id was
+// added.
+type strategyResourceModelV0 struct {
+	ID                    types.String  `tfsdk:"id"`
+	DisplayName           types.String  `tfsdk:"display_name"`
+	OverloadRate          types.Float32 `tfsdk:"overload_rate"`
+	ExercisesPerWorkout   types.Int32   `tfsdk:"exercises_per_workout"`
+	TargetSetsPerExercise types.Int32   `tfsdk:"target_sets_per_exercise"`
+	TargetRepsPerSet      types.Int32   `tfsdk:"target_reps_per_set"`
+	WeightUnit            types.String  `tfsdk:"weight_unit"`
+}
+
+// UpgradeState migrates state written by earlier versions of the schema.
+func (r *strategyResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 only stored the identifier as a string in id.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                       schema.StringAttribute{Computed: true},
+					"display_name":             schema.StringAttribute{Required: true},
+					"overload_rate":            schema.Float32Attribute{Required: true},
+					"exercises_per_workout":    schema.Int32Attribute{Required: true},
+					"target_sets_per_exercise": schema.Int32Attribute{Required: true},
+					"target_reps_per_set":      schema.Int32Attribute{Required: true},
+					"weight_unit":              schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorState strategyResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				strategyID, err := strconv.ParseInt(priorState.ID.ValueString(), 10, 64)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Upgrade BrickByBrick Strategy State",
+						"Could not parse strategy ID "+priorState.ID.String()+" as a number: "+err.Error(),
+					)
+					return
+				}
+
+				upgradedState := strategyResourceModel{
+					ID:                    priorState.ID,
+					StrategyID:            types.Int64Value(strategyID),
+					DisplayName:           priorState.DisplayName,
+					OverloadRate:          WeightValue{Float32Value: priorState.OverloadRate, precision: defaultWeightPrecision},
+					ExercisesPerWorkout:   priorState.ExercisesPerWorkout,
+					TargetSetsPerExercise: priorState.TargetSetsPerExercise,
+					TargetRepsPerSet:      priorState.TargetRepsPerSet,
+					WeightUnit:            priorState.WeightUnit,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedState)...)
+			},
+		},
+	}
+}
+
+// refresh overwrites the model with the values returned by the API,
+// converting overload_rate into weightUnit.
+func (m *strategyResourceModel) refresh(strategy *Strategy, weightUnit string, weightPrecision float64) {
+	m.ID = types.StringValue(strconv.Itoa(strategy.ID))
+	m.StrategyID = types.Int64Value(int64(strategy.ID))
+	m.DisplayName = types.StringValue(strategy.DisplayName)
+	m.EquipmentProfileID = types.Int64PointerValue(int64PointerValue(strategy.EquipmentProfileID))
+
+	// The API stores the loadable overload rate, so overload_rate keeps its
+	// configured value for as long as the stored rate is within
+	// weightPrecision of what it was snapped to.
+	loaded := weightFromPounds(strategy.OverloadRate, weightUnit, defaultWeightPrecision)
+	if m.LoadableOverloadRate.IsNull() || m.LoadableOverloadRate.IsUnknown() ||
+		weightChanged(loaded, weightUnit, m.loadedOverloadRate(), weightUnit, weightPrecision) {
+		m.OverloadRate = loaded
+		m.LoadableOverloadRate = types.Float32Value(loaded.ValueFloat32())
+	}
+	m.ExercisesPerWorkout = types.Int32Value(strategy.ExercisesPerWorkout)
+	m.TargetSetsPerExercise = types.Int32Value(strategy.TargetSetsPerExercise)
+	m.TargetRepsPerSet = types.Int32Value(strategy.TargetRepsPerSet)
+	m.CreatedAt = timestampValueOrNull(strategy.CreatedAt)
+	m.UpdatedAt = timestampValueOrNull(strategy.UpdatedAt)
+	m.OwnerID = stringValueOrNull(strategy.OwnerID)
+}
+
+// joinStrategyIDs returns the IDs of strategies as a comma-separated list.
+func joinStrategyIDs(strategies []Strategy) string {
+	ids := make([]string, 0, len(strategies))
+	for _, strategy := range strategies {
+		ids = append(ids, strconv.Itoa(strategy.ID))
+	}
+	return strings.Join(ids, ", ")
 }
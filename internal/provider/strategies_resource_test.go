@@ -0,0 +1,452 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStrategyResourceUpgradeStateV0(t *testing.T) {
+	testCases := map[string]struct {
+		rawState       string
+		wantStrategyID int64
+		wantName       string
+		wantError      bool
+	}{
+		"minimal": {
+			rawState: `{
+				"id": "12",
+				"display_name": "5x5",
+				"overload_rate": 5,
+				"exercises_per_workout": 3,
+				"target_sets_per_exercise": 5,
+				"target_reps_per_set": 5
+			}`,
+			wantStrategyID: 12,
+			wantName:       "5x5",
+		},
+		"with-weight-unit": {
+			rawState: `{
+				"id": "3",
+				"display_name": "Metric 5x5",
+				"overload_rate": 2.5,
+				"exercises_per_workout": 3,
+				"target_sets_per_exercise": 5,
+				"target_reps_per_set": 5,
+				"weight_unit": "kg"
+			}`,
+			wantStrategyID: 3,
+			wantName:       "Metric 5x5",
+		},
+		"non-numeric-id": {
+			rawState: `{
+				"id": "",
+				"display_name": "5x5",
+				"overload_rate": 5,
+				"exercises_per_workout": 3,
+				"target_sets_per_exercise": 5,
+				"target_reps_per_set": 5
+			}`,
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state, diags := testUpgradeResourceState(t, "brickbybrick_strategy", 0, testCase.rawState)
+
+			if testCase.wantError {
+				if state != nil {
+					t.Fatalf("expected an error, got upgraded state: %v", state)
+				}
+				if len(diags) == 0 || diags[0].Summary != "Unable to Upgrade BrickByBrick Strategy State" {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if state == nil {
+				t.Fatalf("unexpected error upgrading state: %v", diags)
+			}
+
+			var strategyID big.Float
+			if err := state["strategy_id"].As(&strategyID); err != nil {
+				t.Fatalf("unexpected error reading strategy_id: %s", err)
+			}
+			if got, _ := strategyID.Int64(); got != testCase.wantStrategyID {
+				t.Errorf("expected strategy_id %d, got %d", testCase.wantStrategyID, got)
+			}
+
+			if !state["display_name"].Equal(tftypes.NewValue(tftypes.String, testCase.wantName)) {
+				t.Errorf("expected display_name %q, got %s", testCase.wantName, state["display_name"])
+			}
+		})
+	}
+}
+
+func TestExercisesOverloadedBy(t *testing.T) {
+	exercises := []Exercise{
+		{ID: 1, Name: "Squat", DefaultWeight: 135},
+		{ID: 2, Name: "Lateral Raise", DefaultWeight: 10},
+		{ID: 3, Name: "Curl", DefaultWeight: 20},
+		{ID: 4, Name: "Pull Up", DefaultWeight: 0},
+	}
+	templates := []WorkoutTemplate{
+		{ID: 1, StrategyID: 7, Exercises: []WorkoutTemplateExercise{{ExerciseID: 1}, {ExerciseID: 2}}},
+		{ID: 2, StrategyID: 7, Exercises: []WorkoutTemplateExercise{{ExerciseID: 4}}},
+		{ID: 3, StrategyID: 8, Exercises: []WorkoutTemplateExercise{{ExerciseID: 3}}},
+	}
+
+	testCases := map[string]struct {
+		workoutTemplates    []WorkoutTemplate
+		overloadRate        float32
+		maxOverloadFraction float32
+		want                []int
+	}{
+		"no-templates": {
+			overloadRate:        10,
+			maxOverloadFraction: 0.5,
+		},
+		"below-fraction": {
+			workoutTemplates:    templates,
+			overloadRate:        5,
+			maxOverloadFraction: 0.5,
+		},
+		"above-fraction": {
+			workoutTemplates:    templates,
+			overloadRate:        10,
+			maxOverloadFraction: 0.5,
+			want:                []int{2},
+		},
+		"other-strategy-templates-are-ignored": {
+			workoutTemplates:    templates,
+			overloadRate:        15,
+			maxOverloadFraction: 0.5,
+			want:                []int{2},
+		},
+		"bodyweight-exercises-are-ignored": {
+			workoutTemplates:    templates,
+			overloadRate:        100,
+			maxOverloadFraction: 0.5,
+			want:                []int{1, 2},
+		},
+		"zero-fraction": {
+			workoutTemplates:    templates,
+			overloadRate:        1,
+			maxOverloadFraction: 0,
+			want:                []int{1, 2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got []int
+			for _, exercise := range exercisesOverloadedBy(exercises, testCase.workoutTemplates, 7, testCase.overloadRate, testCase.maxOverloadFraction) {
+				got = append(got, exercise.ID)
+			}
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("expected exercises %v, got %v", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestStrategyPatch(t *testing.T) {
+	state := strategyResourceModel{
+		DisplayName:           types.StringValue("5x5"),
+		OverloadRate:          NewWeightValue(5, defaultWeightPrecision),
+		ExercisesPerWorkout:   types.Int32Value(3),
+		TargetSetsPerExercise: types.Int32Value(5),
+		TargetRepsPerSet:      types.Int32Value(5),
+		EquipmentProfileID:    types.Int64Value(2),
+		LoadableOverloadRate:  types.Float32Value(5),
+	}
+
+	testCases := map[string]struct {
+		modify          func(plan *strategyResourceModel)
+		planWeightUnit  string
+		stateWeightUnit string
+		weightPrecision float64
+		want            map[string]any
+	}{
+		"unchanged": {
+			modify: func(plan *strategyResourceModel) {},
+			want:   map[string]any{},
+		},
+		"display-name": {
+			modify: func(plan *strategyResourceModel) { plan.DisplayName = types.StringValue("Starting Strength") },
+			want:   map[string]any{"display_name": "Starting Strength"},
+		},
+		"overload-rate": {
+			modify: func(plan *strategyResourceModel) {
+				plan.OverloadRate = NewWeightValue(10, defaultWeightPrecision)
+				plan.LoadableOverloadRate = types.Float32Unknown()
+			},
+			want: map[string]any{"overload_rate": float32(10)},
+		},
+		"overload-rate-within-precision": {
+			modify: func(plan *strategyResourceModel) {
+				plan.OverloadRate = NewWeightValue(5.2, defaultWeightPrecision)
+				plan.LoadableOverloadRate = types.Float32Unknown()
+			},
+			weightPrecision: 0.5,
+			want:            map[string]any{},
+		},
+		"loadable-overload-rate": {
+			modify: func(plan *strategyResourceModel) {
+				plan.OverloadRate = NewWeightValue(6, defaultWeightPrecision)
+				plan.LoadableOverloadRate = types.Float32Value(5)
+			},
+			want: map[string]any{},
+		},
+		"rounded-loadable-overload-rate": {
+			modify: func(plan *strategyResourceModel) {
+				plan.OverloadRate = NewWeightValue(4, defaultWeightPrecision)
+				plan.LoadableOverloadRate = types.Float32Value(2.5)
+			},
+			want: map[string]any{"overload_rate": float32(2.5)},
+		},
+		"no-loadable-overload-rate": {
+			modify: func(plan *strategyResourceModel) {
+				plan.OverloadRate = NewWeightValue(7.5, defaultWeightPrecision)
+				plan.LoadableOverloadRate = types.Float32Null()
+			},
+			want: map[string]any{"overload_rate": float32(7.5)},
+		},
+		"weight-unit-only": {
+			modify: func(plan *strategyResourceModel) {
+				plan.OverloadRate = NewWeightValue(fromPounds(5, weightUnitKilograms), defaultWeightPrecision)
+				plan.LoadableOverloadRate = types.Float32Value(fromPounds(5, weightUnitKilograms))
+			},
+			planWeightUnit: weightUnitKilograms,
+			want:           map[string]any{},
+		},
+		"kilograms": {
+			modify: func(plan *strategyResourceModel) {
+				plan.OverloadRate = NewWeightValue(2.5, defaultWeightPrecision)
+				plan.LoadableOverloadRate = types.Float32Value(2.5)
+			},
+			planWeightUnit: weightUnitKilograms,
+			want:           map[string]any{"overload_rate": toPounds(2.5, weightUnitKilograms)},
+		},
+		"removed-equipment-profile": {
+			modify: func(plan *strategyResourceModel) { plan.EquipmentProfileID = types.Int64Null() },
+			want:   map[string]any{"equipment_profile_id": (*int64)(nil)},
+		},
+		"volume": {
+			modify: func(plan *strategyResourceModel) {
+				plan.ExercisesPerWorkout = types.Int32Value(4)
+				plan.TargetSetsPerExercise = types.Int32Value(3)
+				plan.TargetRepsPerSet = types.Int32Value(8)
+			},
+			want: map[string]any{
+				"exercises_per_workout":    int32(4),
+				"target_sets_per_exercise": int32(3),
+				"target_reps_per_set":      int32(8),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plan := state
+			testCase.modify(&plan)
+
+			planWeightUnit, stateWeightUnit := testCase.planWeightUnit, testCase.stateWeightUnit
+			if planWeightUnit == "" {
+				planWeightUnit = weightUnitPounds
+			}
+			if stateWeightUnit == "" {
+				stateWeightUnit = weightUnitPounds
+			}
+			weightPrecision := testCase.weightPrecision
+			if weightPrecision == 0 {
+				weightPrecision = defaultWeightPrecision
+			}
+
+			got := strategyPatch(plan, state, planWeightUnit, stateWeightUnit, weightPrecision)
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("expected changes %v, got %v", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestStrategyResourceImportStateByDisplayName(t *testing.T) {
+	strategies := []Strategy{
+		{ID: 1, DisplayName: "5x5"},
+		{ID: 2, DisplayName: "Starting Strength"},
+		{ID: 3, DisplayName: "Hypertrophy"},
+		{ID: 4, DisplayName: "Hypertrophy"},
+	}
+
+	testCases := map[string]struct {
+		importID    string
+		serverError bool
+		wantID      string
+		wantSummary string
+	}{
+		"numeric-id": {
+			importID: "7",
+			wantID:   "7",
+		},
+		"single-match": {
+			importID: "display_name:Starting Strength",
+			wantID:   "2",
+		},
+		"no-match": {
+			importID:    "display_name:starting strength",
+			wantSummary: "Strategy Not Found",
+		},
+		"several-matches": {
+			importID:    "display_name:Hypertrophy",
+			wantSummary: "Ambiguous Strategy Display Name",
+		},
+		"server-error": {
+			importID:    "display_name:Starting Strength",
+			serverError: true,
+			wantSummary: "Error Importing BrickByBrick Strategy",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /strategies", func(w http.ResponseWriter, r *http.Request) {
+				if testCase.serverError {
+					http.Error(w, "unavailable", http.StatusServiceUnavailable)
+					return
+				}
+				writeJSON(t, w, strategies)
+			})
+			r := &strategyResource{client: newTestClient(t, mux)}
+
+			gotID, diags := testImportState(t, r, testCase.importID)
+
+			if testCase.wantSummary != "" {
+				if len(diags) != 1 || diags[0].Summary() != testCase.wantSummary {
+					t.Fatalf("expected error %q, got %v", testCase.wantSummary, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error importing: %v", diags)
+			}
+			if gotID.ValueString() != testCase.wantID {
+				t.Errorf("expected id %q, got %s", testCase.wantID, gotID)
+			}
+		})
+	}
+}
+
+func TestStrategyResourceCreateAdoptExisting(t *testing.T) {
+	testCases := map[string]struct {
+		existing      []Strategy
+		adoptExisting bool
+		wantRequests  []string
+		wantID        string
+		wantSummary   string
+	}{
+		"disabled": {
+			existing:     []Strategy{{ID: 2, DisplayName: "5x5"}},
+			wantRequests: []string{"POST /strategies"},
+			wantID:       "100",
+		},
+		"no-match": {
+			existing:      []Strategy{{ID: 1, DisplayName: "Starting Strength"}},
+			adoptExisting: true,
+			wantRequests:  []string{"GET /strategies", "POST /strategies"},
+			wantID:        "100",
+		},
+		"single-match": {
+			existing:      []Strategy{{ID: 1, DisplayName: "Starting Strength"}, {ID: 2, DisplayName: "5x5"}},
+			adoptExisting: true,
+			wantRequests:  []string{"GET /strategies", "PUT /strategies/2", "GET /strategies/2"},
+			wantID:        "2",
+		},
+		"several-matches": {
+			existing:      []Strategy{{ID: 2, DisplayName: "5x5"}, {ID: 3, DisplayName: "5x5"}},
+			adoptExisting: true,
+			wantRequests:  []string{"GET /strategies"},
+			wantSummary:   "Ambiguous Strategy To Adopt",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /strategies", func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(t, w, testCase.existing)
+			})
+			mux.HandleFunc("POST /strategies", func(w http.ResponseWriter, r *http.Request) {
+				var strategy Strategy
+				if err := json.NewDecoder(r.Body).Decode(&strategy); err != nil {
+					t.Errorf("decoding request: %v", err)
+				}
+				strategy.ID = 100
+				writeJSON(t, w, strategy)
+			})
+			mux.HandleFunc("PUT /strategies/{id}", func(w http.ResponseWriter, r *http.Request) {
+				var strategy CreateStrategyPayload
+				if err := json.NewDecoder(r.Body).Decode(&strategy); err != nil {
+					t.Errorf("decoding request: %v", err)
+				}
+				if strategy.OverloadRate != 5 {
+					t.Errorf("expected adopted overload_rate 5, got %g", strategy.OverloadRate)
+				}
+				writeJSON(t, w, strategy)
+			})
+			mux.HandleFunc("GET /strategies/{id}", func(w http.ResponseWriter, r *http.Request) {
+				id, _ := strconv.Atoi(r.PathValue("id"))
+				writeJSON(t, w, Strategy{ID: id, DisplayName: "5x5", OverloadRate: 5, ExercisesPerWorkout: 3, TargetSetsPerExercise: 5, TargetRepsPerSet: 5})
+			})
+
+			var requests []string
+			r := &strategyResource{client: newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				mux.ServeHTTP(w, r)
+			}))}
+
+			state, diags := testCreateResource(t, r, strategyResourceModel{
+				DisplayName:           types.StringValue("5x5"),
+				OverloadRate:          NewWeightValue(5, defaultWeightPrecision),
+				ExercisesPerWorkout:   types.Int32Value(3),
+				TargetSetsPerExercise: types.Int32Value(5),
+				TargetRepsPerSet:      types.Int32Value(5),
+				AdoptExisting:         types.BoolValue(testCase.adoptExisting),
+			})
+
+			if !slices.Equal(requests, testCase.wantRequests) {
+				t.Errorf("expected requests %v, got %v", testCase.wantRequests, requests)
+			}
+			if testCase.wantSummary != "" {
+				if len(diags) != 1 || diags[0].Summary() != testCase.wantSummary {
+					t.Fatalf("expected error %q, got %v", testCase.wantSummary, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating: %v", diags)
+			}
+
+			var gotID types.String
+			if diags := state.GetAttribute(context.Background(), path.Root("id"), &gotID); diags.HasError() {
+				t.Fatalf("unexpected error reading id: %v", diags)
+			}
+			if gotID.ValueString() != testCase.wantID {
+				t.Errorf("expected id %q, got %s", testCase.wantID, gotID)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &strategyDataSource{}
+	_ datasource.DataSourceWithConfigure        = &strategyDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &strategyDataSource{}
+)
+
+func NewStrategyDataSource() datasource.DataSource {
+	return &strategyDataSource{}
+}
+
+type strategyDataSource struct {
+	client *BrickByBrickClient
+}
+
+type strategyDataSourceModel struct {
+	ID                    types.Int64   `tfsdk:"id"`
+	DisplayName           types.String  `tfsdk:"display_name"`
+	WeightUnit            types.String  `tfsdk:"weight_unit"`
+	OverloadRate          types.Float32 `tfsdk:"overload_rate"`
+	ExercisesPerWorkout   types.Int32   `tfsdk:"exercises_per_workout"`
+	TargetSetsPerExercise types.Int32   `tfsdk:"target_sets_per_exercise"`
+	TargetRepsPerSet      types.Int32   `tfsdk:"target_reps_per_set"`
+	Archived              types.Bool    `tfsdk:"archived"`
+	CreatedAt             types.String  `tfsdk:"created_at"`
+	UpdatedAt             types.String  `tfsdk:"updated_at"`
+	OwnerID               types.String  `tfsdk:"owner_id"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *strategyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *strategyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_strategy"
+}
+
+// Schema defines the schema for the data source.
+func (d *strategyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single strategy by its numeric ID or its exact display name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the strategy. Exactly one of id or display_name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The exact display name of the strategy, including case. Archived strategies are not matched by display name. Exactly one of id or display_name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"weight_unit": schema.StringAttribute{
+				Description: "The unit, lb or kg, that weights are returned in. Defaults to the provider weight_unit.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"overload_rate": schema.Float32Attribute{
+				Description: "The amount of resistance or weight to add (in weight_unit) to each rep per session.",
+				Computed:    true,
+			},
+			"exercises_per_workout": schema.Int32Attribute{
+				Description: "The number of exercises that each workout should have.",
+				Computed:    true,
+			},
+			"target_sets_per_exercise": schema.Int32Attribute{
+				Description: "The goal for the number of sets you eventually want to do for each exercise in a workout.",
+				Computed:    true,
+			},
+			"target_reps_per_set": schema.Int32Attribute{
+				Description: "The goal for the number of reps that you eventually want to do in a set.",
+				Computed:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Description: "Whether the strategy has been archived.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the strategy was created, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the strategy was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The identifier of the account that owns the strategy.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires the strategy to be looked up by exactly one of
+// id or display_name.
+func (d *strategyDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("display_name"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *strategyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state strategyDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	weightUnit := resolveWeightUnit(state.WeightUnit, d.client)
+
+	var strategy *Strategy
+	if !state.ID.IsNull() {
+		var err error
+		strategy, err = d.client.GetStrategy(strconv.FormatInt(state.ID.ValueInt64(), 10))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Unable to Read BrickByBrick Strategy",
+				"Could not read strategy ID "+state.ID.String()+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		strategy = d.findByDisplayName(state.DisplayName.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state.refresh(strategy, weightUnit)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findByDisplayName returns the only unarchived strategy with exactly the
+// given display name, adding an error when there is none or more than one.
+func (d *strategyDataSource) findByDisplayName(displayName string, diags *diag.Diagnostics) *Strategy {
+	matches, err := d.client.FindStrategiesByDisplayName(displayName)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read BrickByBrick Strategy",
+			"Could not list strategies to resolve display name "+strconv.Quote(displayName)+": "+err.Error(),
+		)
+		return nil
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("display_name"),
+			"Strategy Not Found",
+			"No strategy has the display name "+strconv.Quote(displayName)+". Display names are matched exactly, including case.",
+		)
+		return nil
+	case 1:
+		return &matches[0]
+	default:
+		diags.AddAttributeError(
+			path.Root("display_name"),
+			"Ambiguous Strategy Display Name",
+			fmt.Sprintf("%d strategies have the display name %q (IDs %s). Look one of them up by its numeric id instead.",
+				len(matches), displayName, joinStrategyIDs(matches)),
+		)
+		return nil
+	}
+}
+
+// refresh maps a strategy returned by the API onto the data source model.
+func (m *strategyDataSourceModel) refresh(strategy *Strategy, weightUnit string) {
+	m.ID = types.Int64Value(int64(strategy.ID))
+	m.DisplayName = types.StringValue(strategy.DisplayName)
+	m.OverloadRate = types.Float32Value(fromPounds(strategy.OverloadRate, weightUnit))
+	m.ExercisesPerWorkout = types.Int32Value(strategy.ExercisesPerWorkout)
+	m.TargetSetsPerExercise = types.Int32Value(strategy.TargetSetsPerExercise)
+	m.TargetRepsPerSet = types.Int32Value(strategy.TargetRepsPerSet)
+	m.Archived = types.BoolValue(strategy.Archived)
+	m.CreatedAt = timestampValueOrNull(strategy.CreatedAt)
+	m.UpdatedAt = timestampValueOrNull(strategy.UpdatedAt)
+	m.OwnerID = stringValueOrNull(strategy.OwnerID)
+}
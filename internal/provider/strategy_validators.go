@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// strategyWorkoutRepsWarning is the total number of reps per workout
+	// above which a strategy is reported as unusually high volume.
+	strategyWorkoutRepsWarning = 1000
+
+	// strategyWorkoutRepsLimit is the total number of reps per workout above
+	// which a strategy is rejected as implausible.
+	strategyWorkoutRepsLimit = 5000
+)
+
+var _ resource.ConfigValidator = strategyWorkoutVolumeValidator{}
+
+// strategyWorkoutVolumeValidator checks that the total number of reps in a
+// workout, exercises_per_workout * target_sets_per_exercise *
+// target_reps_per_set, is plausible.
+type strategyWorkoutVolumeValidator struct{}
+
+func (v strategyWorkoutVolumeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("exercises_per_workout * target_sets_per_exercise * target_reps_per_set must be at most %d", strategyWorkoutRepsLimit)
+}
+
+func (v strategyWorkoutVolumeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v strategyWorkoutVolumeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var exercisesPerWorkout, targetSetsPerExercise, targetRepsPerSet types.Int32
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("exercises_per_workout"), &exercisesPerWorkout)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_sets_per_exercise"), &targetSetsPerExercise)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_reps_per_set"), &targetRepsPerSet)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values that are not known until apply are checked once they are.
+	for _, value := range []types.Int32{exercisesPerWorkout, targetSetsPerExercise, targetRepsPerSet} {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+	}
+
+	totalReps := int64(exercisesPerWorkout.ValueInt32()) * int64(targetSetsPerExercise.ValueInt32()) * int64(targetRepsPerSet.ValueInt32())
+
+	switch {
+	case totalReps > strategyWorkoutRepsLimit:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_reps_per_set"),
+			"Implausible Workout Volume",
+			fmt.Sprintf("This strategy prescribes %d total reps per workout (%d exercises * %d sets * %d reps), which is above the limit of %d. "+
+				"Reduce exercises_per_workout, target_sets_per_exercise or target_reps_per_set.",
+				totalReps, exercisesPerWorkout.ValueInt32(), targetSetsPerExercise.ValueInt32(), targetRepsPerSet.ValueInt32(), strategyWorkoutRepsLimit),
+		)
+	case totalReps > strategyWorkoutRepsWarning:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("target_reps_per_set"),
+			"High Workout Volume",
+			fmt.Sprintf("This strategy prescribes %d total reps per workout (%d exercises * %d sets * %d reps). "+
+				"Check that this is intended.",
+				totalReps, exercisesPerWorkout.ValueInt32(), targetSetsPerExercise.ValueInt32(), targetRepsPerSet.ValueInt32()),
+		)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStrategyWorkoutVolumeValidator(t *testing.T) {
+	volumeSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"exercises_per_workout":    schema.Int32Attribute{Optional: true},
+			"target_sets_per_exercise": schema.Int32Attribute{Optional: true},
+			"target_reps_per_set":      schema.Int32Attribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"exercises_per_workout":    tftypes.Number,
+		"target_sets_per_exercise": tftypes.Number,
+		"target_reps_per_set":      tftypes.Number,
+	}}
+	number := func(value any) tftypes.Value {
+		return tftypes.NewValue(tftypes.Number, value)
+	}
+
+	testCases := map[string]struct {
+		exercisesPerWorkout   tftypes.Value
+		targetSetsPerExercise tftypes.Value
+		targetRepsPerSet      tftypes.Value
+		wantSeverity          diag.Severity
+		wantSummary           string
+	}{
+		"typical": {
+			exercisesPerWorkout:   number(3),
+			targetSetsPerExercise: number(5),
+			targetRepsPerSet:      number(5),
+		},
+		"at-warning-threshold": {
+			exercisesPerWorkout:   number(10),
+			targetSetsPerExercise: number(10),
+			targetRepsPerSet:      number(10),
+		},
+		"above-warning-threshold": {
+			exercisesPerWorkout:   number(10),
+			targetSetsPerExercise: number(10),
+			targetRepsPerSet:      number(11),
+			wantSeverity:          diag.SeverityWarning,
+			wantSummary:           "High Workout Volume",
+		},
+		"at-limit": {
+			exercisesPerWorkout:   number(10),
+			targetSetsPerExercise: number(10),
+			targetRepsPerSet:      number(50),
+			wantSeverity:          diag.SeverityWarning,
+			wantSummary:           "High Workout Volume",
+		},
+		"above-limit": {
+			exercisesPerWorkout:   number(10),
+			targetSetsPerExercise: number(10),
+			targetRepsPerSet:      number(51),
+			wantSeverity:          diag.SeverityError,
+			wantSummary:           "Implausible Workout Volume",
+		},
+		"overflows-int32": {
+			exercisesPerWorkout:   number(100000),
+			targetSetsPerExercise: number(100000),
+			targetRepsPerSet:      number(100000),
+			wantSeverity:          diag.SeverityError,
+			wantSummary:           "Implausible Workout Volume",
+		},
+		"null": {
+			exercisesPerWorkout:   number(100),
+			targetSetsPerExercise: number(nil),
+			targetRepsPerSet:      number(100),
+		},
+		"unknown": {
+			exercisesPerWorkout:   number(100),
+			targetSetsPerExercise: number(100),
+			targetRepsPerSet:      number(tftypes.UnknownValue),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: volumeSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"exercises_per_workout":    testCase.exercisesPerWorkout,
+						"target_sets_per_exercise": testCase.targetSetsPerExercise,
+						"target_reps_per_set":      testCase.targetRepsPerSet,
+					}),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			strategyWorkoutVolumeValidator{}.ValidateResource(context.Background(), req, resp)
+
+			if testCase.wantSummary == "" {
+				if len(resp.Diagnostics) != 0 {
+					t.Fatalf("expected no diagnostics, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if len(resp.Diagnostics) != 1 {
+				t.Fatalf("expected one diagnostic, got %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics[0]; got.Severity() != testCase.wantSeverity || got.Summary() != testCase.wantSummary {
+				t.Errorf("expected %s %q, got %s %q", testCase.wantSeverity, testCase.wantSummary, got.Severity(), got.Summary())
+			}
+		})
+	}
+}
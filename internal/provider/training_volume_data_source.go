@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &trainingVolumeDataSource{}
+	_ datasource.DataSourceWithConfigure = &trainingVolumeDataSource{}
+)
+
+// defaultSecondaryMuscleWeighting is the secondary_muscle_weighting when it
+// is not set: a set counts as half a set for the muscles it only assists.
+const defaultSecondaryMuscleWeighting = 0.5
+
+func NewTrainingVolumeDataSource() datasource.DataSource {
+	return &trainingVolumeDataSource{}
+}
+
+type trainingVolumeDataSource struct {
+	client *BrickByBrickClient
+}
+
+type trainingVolumeDataSourceModel struct {
+	From                     types.String                     `tfsdk:"from"`
+	To                       types.String                     `tfsdk:"to"`
+	ExerciseIDs              []types.Int64                    `tfsdk:"exercise_ids"`
+	SecondaryMuscleWeighting types.Float32                    `tfsdk:"secondary_muscle_weighting"`
+	WeightUnit               types.String                     `tfsdk:"weight_unit"`
+	TotalVolume              types.Float32                    `tfsdk:"total_volume"`
+	Exercises                []trainingVolumeExerciseModel    `tfsdk:"exercises"`
+	MuscleGroups             []trainingVolumeMuscleGroupModel `tfsdk:"muscle_groups"`
+	Weeks                    []trainingVolumeWeekModel        `tfsdk:"weeks"`
+}
+
+type trainingVolumeWeekModel struct {
+	WeekStart    types.String                     `tfsdk:"week_start"`
+	Volume       types.Float32                    `tfsdk:"volume"`
+	Exercises    []trainingVolumeExerciseModel    `tfsdk:"exercises"`
+	MuscleGroups []trainingVolumeMuscleGroupModel `tfsdk:"muscle_groups"`
+}
+
+type trainingVolumeExerciseModel struct {
+	ExerciseID types.Int64   `tfsdk:"exercise_id"`
+	Sets       types.Int32   `tfsdk:"sets"`
+	Reps       types.Int32   `tfsdk:"reps"`
+	Volume     types.Float32 `tfsdk:"volume"`
+}
+
+type trainingVolumeMuscleGroupModel struct {
+	MuscleGroup types.String  `tfsdk:"muscle_group"`
+	Sets        types.Float32 `tfsdk:"sets"`
+	Volume      types.Float32 `tfsdk:"volume"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *trainingVolumeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *trainingVolumeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_training_volume"
+}
+
+// trainingVolumeAttributes returns the exercises and muscle_groups
+// breakdowns, shared by the whole date range and each of its weeks.
+func trainingVolumeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"exercises": schema.ListNestedAttribute{
+			Description: "The volume of each exercise, ordered by exercise_id.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"exercise_id": schema.Int64Attribute{
+						Description: "The exercise_id of the exercise.",
+						Computed:    true,
+					},
+					"sets": schema.Int32Attribute{
+						Description: "The number of sets performed.",
+						Computed:    true,
+					},
+					"reps": schema.Int32Attribute{
+						Description: "The number of reps performed across those sets.",
+						Computed:    true,
+					},
+					"volume": schema.Float32Attribute{
+						Description: "The sum of weight times reps across those sets, in weight_unit.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"muscle_groups": schema.ListNestedAttribute{
+			Description: "The volume of each muscle group, ordered by name. A set counts in full towards the primary muscles of its exercise, and scaled by secondary_muscle_weighting towards its secondary muscles.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"muscle_group": schema.StringAttribute{
+						Description: "The muscle group.",
+						Computed:    true,
+					},
+					"sets": schema.Float32Attribute{
+						Description: "The weighted number of sets that trained the muscle group.",
+						Computed:    true,
+					},
+					"volume": schema.Float32Attribute{
+						Description: "The weighted volume that trained the muscle group, in weight_unit.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *trainingVolumeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	weekAttributes := trainingVolumeAttributes()
+	weekAttributes["week_start"] = schema.StringAttribute{
+		Description: "The Monday the week starts on, in YYYY-MM-DD format.",
+		Computed:    true,
+	}
+	weekAttributes["volume"] = schema.Float32Attribute{
+		Description: "The volume of every exercise in the week, in weight_unit.",
+		Computed:    true,
+	}
+
+	attributes := trainingVolumeAttributes()
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"from": schema.StringAttribute{
+			Description: "Only count sessions on or after this date, in YYYY-MM-DD format.",
+			Optional:    true,
+			Validators: []validator.String{
+				dateValidator{},
+			},
+		},
+		"to": schema.StringAttribute{
+			Description: "Only count sessions on or before this date, in YYYY-MM-DD format.",
+			Optional:    true,
+			Validators: []validator.String{
+				dateValidator{},
+			},
+		},
+		"exercise_ids": schema.SetAttribute{
+			Description: "Only count these exercises.",
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"secondary_muscle_weighting": schema.Float32Attribute{
+			Description: fmt.Sprintf("The fraction of a set counted towards the secondary muscles of its exercise, from 0 to 1. Defaults to %g.", defaultSecondaryMuscleWeighting),
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Float32{
+				float32validator.Between(0, 1),
+			},
+		},
+		"weight_unit": schema.StringAttribute{
+			Description: "The unit, lb or kg, that volumes are returned in. Defaults to the provider weight_unit.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(weightUnits...),
+			},
+		},
+		"total_volume": schema.Float32Attribute{
+			Description: "The volume of every exercise in the date range, in weight_unit.",
+			Computed:    true,
+		},
+		"weeks": schema.ListNestedAttribute{
+			Description: "The volume of each week with at least one set, oldest first.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: weekAttributes,
+			},
+		},
+	})
+
+	resp.Schema = schema.Schema{
+		Description: "Aggregates the volume, sets times reps times weight, of logged workout sessions per week, per exercise and per muscle group. Only completed sets count.",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *trainingVolumeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state trainingVolumeDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	weightUnit := resolveWeightUnit(state.WeightUnit, d.client)
+	weightPrecision := resolveWeightPrecision(types.Float64Null(), d.client)
+
+	// Dates in YYYY-MM-DD format sort in date order.
+	if !state.From.IsNull() && !state.To.IsNull() && state.From.ValueString() > state.To.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("to"),
+			"Invalid Date Range",
+			"to must be on or after from.",
+		)
+		return
+	}
+
+	if state.SecondaryMuscleWeighting.IsNull() {
+		state.SecondaryMuscleWeighting = types.Float32Value(defaultSecondaryMuscleWeighting)
+	}
+
+	options := WorkoutSessionListOptions{
+		From: state.From.ValueString(),
+		To:   state.To.ValueString(),
+	}
+	for _, exerciseID := range state.ExerciseIDs {
+		options.ExerciseIDs = append(options.ExerciseIDs, int(exerciseID.ValueInt64()))
+	}
+
+	volume, err := readTrainingVolume(d.client, options, state.SecondaryMuscleWeighting.ValueFloat32())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read BrickByBrick Training Volume",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.TotalVolume = weightPointerValue(&volume.Total.Volume, weightUnit, weightPrecision)
+	state.Exercises = trainingVolumeExerciseValues(volume.Total, weightUnit, weightPrecision)
+	state.MuscleGroups = trainingVolumeMuscleGroupValues(volume.Total, weightUnit, weightPrecision)
+	state.Weeks = []trainingVolumeWeekModel{}
+	for _, week := range volume.Weeks {
+		state.Weeks = append(state.Weeks, trainingVolumeWeekModel{
+			WeekStart:    types.StringValue(week.WeekStart),
+			Volume:       weightPointerValue(&week.Volume, weightUnit, weightPrecision),
+			Exercises:    trainingVolumeExerciseValues(week.trainingVolumeBreakdown, weightUnit, weightPrecision),
+			MuscleGroups: trainingVolumeMuscleGroupValues(week.trainingVolumeBreakdown, weightUnit, weightPrecision),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// readTrainingVolume lists the exercises and the workout sessions matching
+// options, and aggregates their volume. The API has no aggregate endpoint, so
+// every session in the date range is fetched.
+func readTrainingVolume(client *BrickByBrickClient, options WorkoutSessionListOptions, secondaryMuscleWeighting float32) (*trainingVolume, error) {
+	// Archived exercises are included, as their sessions still count.
+	exercises, err := client.GetExercises(true)
+	if err != nil {
+		return nil, fmt.Errorf("could not list exercises: %w", err)
+	}
+
+	sessions, err := client.ListAllWorkoutSessions(options)
+	if err != nil {
+		return nil, fmt.Errorf("could not list workout sessions: %w", err)
+	}
+
+	exercisesByID := map[int]Exercise{}
+	for _, exercise := range exercises {
+		exercisesByID[exercise.ID] = exercise
+	}
+
+	volume := trainingVolumeFromSessions(filterSessionExercises(sessions, options.ExerciseIDs), exercisesByID, secondaryMuscleWeighting)
+	return &volume, nil
+}
+
+// trainingVolume is the volume of a date range and each of its weeks, with
+// weights in lbs.
+type trainingVolume struct {
+	Total trainingVolumeBreakdown
+	Weeks []trainingVolumeWeek
+}
+
+type trainingVolumeWeek struct {
+	WeekStart string
+	trainingVolumeBreakdown
+}
+
+type trainingVolumeBreakdown struct {
+	Volume       float32
+	Exercises    map[int]*trainingVolumeExercise
+	MuscleGroups map[string]*trainingVolumeMuscleGroup
+}
+
+type trainingVolumeExercise struct {
+	Sets   int32
+	Reps   int32
+	Volume float32
+}
+
+type trainingVolumeMuscleGroup struct {
+	Sets   float32
+	Volume float32
+}
+
+func newTrainingVolumeBreakdown() trainingVolumeBreakdown {
+	return trainingVolumeBreakdown{
+		Exercises:    map[int]*trainingVolumeExercise{},
+		MuscleGroups: map[string]*trainingVolumeMuscleGroup{},
+	}
+}
+
+// add counts one set of exercise, with its primary muscles trained in full and
+// its secondary muscles scaled by secondaryMuscleWeighting.
+func (b *trainingVolumeBreakdown) add(exercise Exercise, set WorkoutSet, secondaryMuscleWeighting float32) {
+	volume := set.Weight * float32(set.Reps)
+	b.Volume += volume
+
+	exerciseVolume, ok := b.Exercises[exercise.ID]
+	if !ok {
+		exerciseVolume = &trainingVolumeExercise{}
+		b.Exercises[exercise.ID] = exerciseVolume
+	}
+	exerciseVolume.Sets++
+	exerciseVolume.Reps += set.Reps
+	exerciseVolume.Volume += volume
+
+	addMuscles := func(muscles []string, weighting float32) {
+		if weighting == 0 {
+			return
+		}
+		for _, muscle := range muscles {
+			muscleVolume, ok := b.MuscleGroups[muscle]
+			if !ok {
+				muscleVolume = &trainingVolumeMuscleGroup{}
+				b.MuscleGroups[muscle] = muscleVolume
+			}
+			muscleVolume.Sets += weighting
+			muscleVolume.Volume += volume * weighting
+		}
+	}
+	addMuscles(exercise.PrimaryMuscles, 1)
+	addMuscles(exercise.SecondaryMuscles, secondaryMuscleWeighting)
+}
+
+// trainingVolumeFromSessions aggregates the volume of every set in sessions,
+// which must be oldest first. Exercises missing from exercisesByID still
+// count towards their own volume, but not towards any muscle group.
+func trainingVolumeFromSessions(sessions []WorkoutSession, exercisesByID map[int]Exercise, secondaryMuscleWeighting float32) trainingVolume {
+	volume := trainingVolume{Total: newTrainingVolumeBreakdown()}
+	for _, session := range sessions {
+		// A date the API should never return only counts towards the total.
+		var week *trainingVolumeWeek
+		if weekStart, err := startOfWeek(session.Date); err == nil {
+			if len(volume.Weeks) == 0 || volume.Weeks[len(volume.Weeks)-1].WeekStart != weekStart {
+				volume.Weeks = append(volume.Weeks, trainingVolumeWeek{
+					WeekStart:               weekStart,
+					trainingVolumeBreakdown: newTrainingVolumeBreakdown(),
+				})
+			}
+			week = &volume.Weeks[len(volume.Weeks)-1]
+		}
+
+		for _, sessionExercise := range session.Exercises {
+			exercise, ok := exercisesByID[sessionExercise.ExerciseID]
+			if !ok {
+				exercise = Exercise{ID: sessionExercise.ExerciseID}
+			}
+			for _, set := range sessionExercise.Sets {
+				// Like personal records and progress, only completed sets
+				// count, so failed attempts do not inflate the volume.
+				if !set.Completed {
+					continue
+				}
+				volume.Total.add(exercise, set, secondaryMuscleWeighting)
+				if week != nil {
+					week.add(exercise, set, secondaryMuscleWeighting)
+				}
+			}
+		}
+	}
+
+	// Drop weeks that only held empty sessions.
+	volume.Weeks = slices.DeleteFunc(volume.Weeks, func(week trainingVolumeWeek) bool {
+		return len(week.Exercises) == 0
+	})
+	return volume
+}
+
+// startOfWeek returns the Monday on or before date, both in YYYY-MM-DD
+// format.
+func startOfWeek(date string) (string, error) {
+	day, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return "", err
+	}
+	daysSinceMonday := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -daysSinceMonday).Format(dateLayout), nil
+}
+
+func trainingVolumeExerciseValues(breakdown trainingVolumeBreakdown, weightUnit string, weightPrecision float64) []trainingVolumeExerciseModel {
+	values := []trainingVolumeExerciseModel{}
+	for _, exerciseID := range slices.Sorted(maps.Keys(breakdown.Exercises)) {
+		exercise := breakdown.Exercises[exerciseID]
+		values = append(values, trainingVolumeExerciseModel{
+			ExerciseID: types.Int64Value(int64(exerciseID)),
+			Sets:       types.Int32Value(exercise.Sets),
+			Reps:       types.Int32Value(exercise.Reps),
+			Volume:     weightPointerValue(&exercise.Volume, weightUnit, weightPrecision),
+		})
+	}
+	return values
+}
+
+func trainingVolumeMuscleGroupValues(breakdown trainingVolumeBreakdown, weightUnit string, weightPrecision float64) []trainingVolumeMuscleGroupModel {
+	values := []trainingVolumeMuscleGroupModel{}
+	for _, muscle := range slices.Sorted(maps.Keys(breakdown.MuscleGroups)) {
+		muscleGroup := breakdown.MuscleGroups[muscle]
+		values = append(values, trainingVolumeMuscleGroupModel{
+			MuscleGroup: types.StringValue(muscle),
+			// Weighted sets are not a weight, so weight_precision does not
+			// apply to them.
+			Sets:   types.Float32Value(roundToPrecision(muscleGroup.Sets, 0.01)),
+			Volume: weightPointerValue(&muscleGroup.Volume, weightUnit, weightPrecision),
+		})
+	}
+	return values
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestReadTrainingVolume(t *testing.T) {
+	exercises := []Exercise{
+		{ID: 1, PrimaryMuscles: []string{"quads"}, SecondaryMuscles: []string{"glutes"}},
+		{ID: 2, PrimaryMuscles: []string{"chest"}, SecondaryMuscles: []string{"triceps"}},
+	}
+	sets := func(weight float32, reps int32, count int) []WorkoutSet {
+		var sets []WorkoutSet
+		for range count {
+			sets = append(sets, WorkoutSet{Weight: weight, Reps: reps, Completed: true})
+		}
+		return sets
+	}
+	sessions := []WorkoutSession{
+		{ID: 1, Date: "2026-01-05", Exercises: []WorkoutSessionExercise{
+			// The failed attempt does not count.
+			{ExerciseID: 1, Sets: append(sets(100, 5, 2), WorkoutSet{Weight: 200, Reps: 1})},
+			{ExerciseID: 2, Sets: sets(100, 10, 1)},
+		}},
+		{ID: 2, Date: "2026-01-07", Exercises: []WorkoutSessionExercise{
+			{ExerciseID: 1, Sets: sets(110, 5, 1)},
+		}},
+		// A Sunday, the last day of the first week.
+		{ID: 3, Date: "2026-01-11", Exercises: []WorkoutSessionExercise{
+			{ExerciseID: 2, Sets: sets(50, 10, 2)},
+		}},
+		// Exercise 99 is not listed, so it trains no muscle group.
+		{ID: 4, Date: "2026-01-12", Exercises: []WorkoutSessionExercise{
+			{ExerciseID: 1, Sets: sets(120, 5, 1)},
+			{ExerciseID: 99, Sets: sets(10, 10, 1)},
+		}},
+		// A week without a completed set is left out.
+		{ID: 5, Date: "2026-01-19", Exercises: []WorkoutSessionExercise{
+			{ExerciseID: 1, Sets: []WorkoutSet{{Weight: 120, Reps: 5}}},
+		}},
+	}
+
+	var sessionRequests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /exercises", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include_archived") != "true" {
+			t.Errorf("exercises listed without include_archived")
+		}
+		writeJSON(t, w, exercises)
+	})
+	mux.HandleFunc("GET /workout-sessions", func(w http.ResponseWriter, r *http.Request) {
+		sessionRequests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		// Return fewer sessions than asked for, so that every page is read.
+		end := min(offset+3, len(sessions))
+		writeJSON(t, w, WorkoutSessionPage{Sessions: sessions[offset:end], TotalCount: int32(len(sessions))})
+	})
+	client := newTestClient(t, mux)
+
+	t.Run("all-exercises", func(t *testing.T) {
+		sessionRequests = 0
+		volume, err := readTrainingVolume(client, WorkoutSessionListOptions{}, 0.5)
+		if err != nil {
+			t.Fatalf("readTrainingVolume: %v", err)
+		}
+
+		if sessionRequests != 2 {
+			t.Errorf("got %d workout session requests, want 2", sessionRequests)
+		}
+		if volume.Total.Volume != 4250 {
+			t.Errorf("got total volume %g, want 4250", volume.Total.Volume)
+		}
+
+		wantExercises := map[int]trainingVolumeExercise{
+			1:  {Sets: 4, Reps: 20, Volume: 2150},
+			2:  {Sets: 3, Reps: 30, Volume: 2000},
+			99: {Sets: 1, Reps: 10, Volume: 100},
+		}
+		if len(volume.Total.Exercises) != len(wantExercises) {
+			t.Errorf("got %d exercises, want %d", len(volume.Total.Exercises), len(wantExercises))
+		}
+		for exerciseID, want := range wantExercises {
+			if got := volume.Total.Exercises[exerciseID]; got == nil || *got != want {
+				t.Errorf("exercise %d: got %+v, want %+v", exerciseID, got, want)
+			}
+		}
+
+		wantMuscleGroups := map[string]trainingVolumeMuscleGroup{
+			"quads":   {Sets: 4, Volume: 2150},
+			"glutes":  {Sets: 2, Volume: 1075},
+			"chest":   {Sets: 3, Volume: 2000},
+			"triceps": {Sets: 1.5, Volume: 1000},
+		}
+		if len(volume.Total.MuscleGroups) != len(wantMuscleGroups) {
+			t.Errorf("got %d muscle groups, want %d", len(volume.Total.MuscleGroups), len(wantMuscleGroups))
+		}
+		for muscle, want := range wantMuscleGroups {
+			if got := volume.Total.MuscleGroups[muscle]; got == nil || *got != want {
+				t.Errorf("muscle group %s: got %+v, want %+v", muscle, got, want)
+			}
+		}
+
+		if len(volume.Weeks) != 2 {
+			t.Fatalf("got %d weeks, want 2", len(volume.Weeks))
+		}
+		for i, want := range []trainingVolumeWeek{
+			{WeekStart: "2026-01-05", trainingVolumeBreakdown: trainingVolumeBreakdown{Volume: 3550}},
+			{WeekStart: "2026-01-12", trainingVolumeBreakdown: trainingVolumeBreakdown{Volume: 700}},
+		} {
+			if got := volume.Weeks[i]; got.WeekStart != want.WeekStart || got.Volume != want.Volume {
+				t.Errorf("week %d: got %s with volume %g, want %s with volume %g", i, got.WeekStart, got.Volume, want.WeekStart, want.Volume)
+			}
+		}
+	})
+
+	t.Run("exercise-filter", func(t *testing.T) {
+		volume, err := readTrainingVolume(client, WorkoutSessionListOptions{ExerciseIDs: []int{2}}, 0)
+		if err != nil {
+			t.Fatalf("readTrainingVolume: %v", err)
+		}
+
+		if volume.Total.Volume != 2000 {
+			t.Errorf("got total volume %g, want 2000", volume.Total.Volume)
+		}
+		if _, ok := volume.Total.MuscleGroups["triceps"]; ok {
+			t.Errorf("secondary muscles counted with a weighting of 0")
+		}
+		if len(volume.Weeks) != 1 || volume.Weeks[0].WeekStart != "2026-01-05" {
+			t.Errorf("got weeks %+v, want only 2026-01-05", volume.Weeks)
+		}
+	})
+
+	t.Run("server-error", func(t *testing.T) {
+		failing := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}))
+		if _, err := readTrainingVolume(failing, WorkoutSessionListOptions{}, 0.5); err == nil {
+			t.Errorf("got no error from a failing server")
+		}
+	})
+}
+
+func TestStartOfWeek(t *testing.T) {
+	testCases := map[string]string{
+		"2026-01-05": "2026-01-05",
+		"2026-01-07": "2026-01-05",
+		"2026-01-11": "2026-01-05",
+		"2026-01-12": "2026-01-12",
+		"2026-03-01": "2026-02-23",
+	}
+
+	for date, want := range testCases {
+		t.Run(date, func(t *testing.T) {
+			got, err := startOfWeek(date)
+			if err != nil {
+				t.Fatalf("startOfWeek(%q): %v", date, err)
+			}
+			if got != want {
+				t.Errorf("startOfWeek(%q) = %q, want %q", date, got, want)
+			}
+		})
+	}
+}
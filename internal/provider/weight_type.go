@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// defaultWeightPrecision is the precision, in the configured weight unit, that
+// the API is trusted to store weights at. It is the default and the finest
+// weight_precision, and the precision of WeightType in schemas, which are
+// built before the provider is configured.
+const defaultWeightPrecision = 0.01
+
+// resolveWeightPrecision returns the weight_precision configured on the
+// resource, falling back to the provider-wide setting and finally to
+// defaultWeightPrecision.
+func resolveWeightPrecision(override types.Float64, client *BrickByBrickClient) float64 {
+	if !override.IsNull() && !override.IsUnknown() {
+		return override.ValueFloat64()
+	}
+	if client != nil && client.WeightPrecision > 0 {
+		return client.WeightPrecision
+	}
+	return defaultWeightPrecision
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.Float32Typable                    = WeightType{}
+	_ basetypes.Float32ValuableWithSemanticEquals = WeightValue{}
+)
+
+// WeightType is a Float32 type for weights that the API stores with limited
+// precision. Values of this type that differ by no more than half of
+// Precision are semantically equal, so a weight that does not round-trip
+// exactly through the API does not produce a diff.
+type WeightType struct {
+	basetypes.Float32Type
+
+	// Precision is the smallest meaningful difference between two weights,
+	// for example 0.01.
+	Precision float64
+}
+
+// Equal returns true if the given type is a WeightType with the same
+// precision.
+func (t WeightType) Equal(o attr.Type) bool {
+	other, ok := o.(WeightType)
+	if !ok {
+		return false
+	}
+
+	return t.Precision == other.Precision && t.Float32Type.Equal(other.Float32Type)
+}
+
+// String returns a human readable string of the type name.
+func (t WeightType) String() string {
+	return fmt.Sprintf("WeightType(%g)", t.Precision)
+}
+
+// ValueFromFloat32 returns a WeightValue given a basetypes.Float32Value.
+func (t WeightType) ValueFromFloat32(_ context.Context, in basetypes.Float32Value) (basetypes.Float32Valuable, diag.Diagnostics) {
+	return WeightValue{
+		Float32Value: in,
+		precision:    t.Precision,
+	}, nil
+}
+
+// ValueFromTerraform returns a WeightValue given a tftypes.Value.
+func (t WeightType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.Float32Type.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	float32Value, ok := attrValue.(basetypes.Float32Value)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	float32Valuable, diags := t.ValueFromFloat32(ctx, float32Value)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting Float32Value to WeightValue: %v", diags)
+	}
+
+	return float32Valuable, nil
+}
+
+// ValueType returns the Value type.
+func (t WeightType) ValueType(_ context.Context) attr.Value {
+	return WeightValue{precision: t.Precision}
+}
+
+// WeightValue is a weight with the precision of its WeightType.
+type WeightValue struct {
+	basetypes.Float32Value
+
+	precision float64
+}
+
+// NewWeightValue returns a known weight with the given precision, rounded to
+// that precision.
+func NewWeightValue(value float32, precision float64) WeightValue {
+	return WeightValue{
+		Float32Value: basetypes.NewFloat32Value(roundToPrecision(value, precision)),
+		precision:    precision,
+	}
+}
+
+// Equal returns true if the given value is a WeightValue with the same
+// precision and exactly the same underlying value.
+func (v WeightValue) Equal(o attr.Value) bool {
+	other, ok := o.(WeightValue)
+	if !ok {
+		return false
+	}
+
+	return v.precision == other.precision && v.Float32Value.Equal(other.Float32Value)
+}
+
+// Type returns a WeightType with the same precision as the value.
+func (v WeightValue) Type(_ context.Context) attr.Type {
+	return WeightType{Precision: v.precision}
+}
+
+// Float32SemanticEquals returns true if the two weights differ by no more
+// than half of the precision.
+func (v WeightValue) Float32SemanticEquals(_ context.Context, newValuable basetypes.Float32Valuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(WeightValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Allow for float32 rounding on top of the precision itself.
+	tolerance := v.precision/2 + v.precision*1e-3
+	difference := math.Abs(float64(v.ValueFloat32()) - float64(newValue.ValueFloat32()))
+
+	return difference <= tolerance, diags
+}
+
+// roundToPrecision rounds value to the nearest multiple of precision.
+func roundToPrecision(value float32, precision float64) float32 {
+	if precision <= 0 {
+		return value
+	}
+	return float32(math.Round(float64(value)/precision) * precision)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWeightValueFloat32SemanticEquals(t *testing.T) {
+	testCases := map[string]struct {
+		prior    WeightValue
+		new      WeightValue
+		expected bool
+	}{
+		"exact": {
+			prior:    NewWeightValue(2.2, 0.01),
+			new:      NewWeightValue(2.2, 0.01),
+			expected: true,
+		},
+		"float32-noise": {
+			prior:    WeightValue{Float32Value: NewWeightValue(2.2, 0.01).Float32Value, precision: 0.01},
+			new:      WeightValue{Float32Value: types.Float32Value(2.2000000476837), precision: 0.01},
+			expected: true,
+		},
+		"kilogram-round-trip": {
+			prior:    NewWeightValue(10, 0.01),
+			new:      WeightValue{Float32Value: types.Float32Value(fromPounds(toPounds(10, weightUnitKilograms), weightUnitKilograms)), precision: 0.01},
+			expected: true,
+		},
+		"within-half-precision": {
+			prior:    WeightValue{Float32Value: types.Float32Value(2.204), precision: 0.01},
+			new:      NewWeightValue(2.2, 0.01),
+			expected: true,
+		},
+		"one-step-apart": {
+			prior:    NewWeightValue(2.2, 0.01),
+			new:      NewWeightValue(2.21, 0.01),
+			expected: false,
+		},
+		"coarser-precision": {
+			prior:    NewWeightValue(45, 2.5),
+			new:      WeightValue{Float32Value: types.Float32Value(46), precision: 2.5},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := testCase.prior.Float32SemanticEquals(context.Background(), testCase.new)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != testCase.expected {
+				t.Errorf("expected %t comparing %s to %s, got %t", testCase.expected, testCase.prior, testCase.new, got)
+			}
+		})
+	}
+}
+
+func TestNewWeightValueNormalizes(t *testing.T) {
+	got := NewWeightValue(2.2000000476837, 0.01).ValueFloat32()
+	if got != 2.2 {
+		t.Errorf("expected 2.2, got %v", got)
+	}
+
+	got = NewWeightValue(fromPounds(toPounds(10, weightUnitKilograms), weightUnitKilograms), 0.01).ValueFloat32()
+	if got != 10 {
+		t.Errorf("expected 10, got %v", got)
+	}
+}
+
+func TestResolveWeightPrecision(t *testing.T) {
+	testCases := map[string]struct {
+		override types.Float64
+		client   *BrickByBrickClient
+		want     float64
+	}{
+		"no-client": {
+			override: types.Float64Null(),
+			want:     defaultWeightPrecision,
+		},
+		"provider": {
+			override: types.Float64Null(),
+			client:   &BrickByBrickClient{WeightPrecision: 0.1},
+			want:     0.1,
+		},
+		"provider-unset": {
+			override: types.Float64Null(),
+			client:   &BrickByBrickClient{},
+			want:     defaultWeightPrecision,
+		},
+		"override": {
+			override: types.Float64Value(0.5),
+			client:   &BrickByBrickClient{WeightPrecision: 0.1},
+			want:     0.5,
+		},
+		"unknown-override": {
+			override: types.Float64Unknown(),
+			client:   &BrickByBrickClient{WeightPrecision: 0.1},
+			want:     0.1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := resolveWeightPrecision(testCase.override, testCase.client); got != testCase.want {
+				t.Errorf("expected %g, got %g", testCase.want, got)
+			}
+		})
+	}
+}
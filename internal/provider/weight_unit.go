@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	weightUnitPounds    = "lb"
+	weightUnitKilograms = "kg"
+
+	// kilogramsPerPound is the exact international avoirdupois conversion.
+	kilogramsPerPound = 0.45359237
+)
+
+// weightUnits are the values accepted by the weight_unit attributes.
+var weightUnits = []string{weightUnitPounds, weightUnitKilograms}
+
+// resolveWeightUnit returns the unit configured on the resource or data
+// source, falling back to the provider-wide setting and finally to lbs.
+func resolveWeightUnit(override types.String, client *BrickByBrickClient) string {
+	if !override.IsNull() && !override.IsUnknown() {
+		return override.ValueString()
+	}
+	if client != nil && client.WeightUnit != "" {
+		return client.WeightUnit
+	}
+	return weightUnitPounds
+}
+
+// toPounds converts a weight in the given unit into lbs, the unit used by the
+// BrickByBrick API.
+func toPounds(value float32, unit string) float32 {
+	if unit == weightUnitKilograms {
+		return float32(float64(value) / kilogramsPerPound)
+	}
+	return value
+}
+
+// fromPounds converts a weight returned by the BrickByBrick API into the
+// given unit.
+func fromPounds(pounds float32, unit string) float32 {
+	if unit == weightUnitKilograms {
+		return float32(float64(pounds) * kilogramsPerPound)
+	}
+	return pounds
+}
+
+// weightFromPounds converts a weight returned by the API into the given unit
+// and normalises it to precision. Differences left over from the conversion
+// are absorbed by the semantic equality of WeightValue.
+func weightFromPounds(pounds float32, unit string, precision float64) WeightValue {
+	return NewWeightValue(fromPounds(pounds, unit), precision)
+}
+
+// weightPointerValue converts an optional weight returned by the API into the
+// given unit, rounded to precision. A nil weight is returned as null.
+func weightPointerValue(pounds *float32, unit string, precision float64) types.Float32 {
+	if pounds == nil {
+		return types.Float32Null()
+	}
+	return types.Float32Value(roundToPrecision(fromPounds(*pounds, unit), precision))
+}
+
+// weightChanged reports whether a planned weight differs from the weight in
+// state by more than precision, after converting both into the planned unit.
+// Switching weight_unit alone is therefore not a change.
+func weightChanged(plan WeightValue, planUnit string, state WeightValue, stateUnit string, precision float64) bool {
+	stateInPlanUnit := NewWeightValue(fromPounds(toPounds(state.ValueFloat32(), stateUnit), planUnit), precision)
+	equal, _ := NewWeightValue(plan.ValueFloat32(), precision).Float32SemanticEquals(context.Background(), stateInPlanUnit)
+	return !equal
+}
+
+// convertWeight converts a weight from one unit into another.
+func convertWeight(value float32, from, to string) float32 {
+	if from == to {
+		return value
+	}
+	return fromPounds(toPounds(value, from), to)
+}
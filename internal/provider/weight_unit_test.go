@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestWeightChanged(t *testing.T) {
+	testCases := map[string]struct {
+		plan      float32
+		planUnit  string
+		state     float32
+		stateUnit string
+		precision float64
+		expected  bool
+	}{
+		"unchanged": {
+			plan:      135,
+			planUnit:  weightUnitPounds,
+			state:     135,
+			stateUnit: weightUnitPounds,
+			precision: defaultWeightPrecision,
+			expected:  false,
+		},
+		"one-step-apart": {
+			plan:      135.01,
+			planUnit:  weightUnitPounds,
+			state:     135,
+			stateUnit: weightUnitPounds,
+			precision: defaultWeightPrecision,
+			expected:  true,
+		},
+		"unit-only": {
+			plan:      fromPounds(135, weightUnitKilograms),
+			planUnit:  weightUnitKilograms,
+			state:     135,
+			stateUnit: weightUnitPounds,
+			precision: defaultWeightPrecision,
+			expected:  false,
+		},
+		"within-coarse-precision": {
+			plan:      60.2,
+			planUnit:  weightUnitKilograms,
+			state:     60,
+			stateUnit: weightUnitKilograms,
+			precision: 0.5,
+			expected:  false,
+		},
+		"beyond-coarse-precision": {
+			plan:      60.5,
+			planUnit:  weightUnitKilograms,
+			state:     60,
+			stateUnit: weightUnitKilograms,
+			precision: 0.5,
+			expected:  true,
+		},
+		"unit-and-coarse-precision": {
+			plan:      60,
+			planUnit:  weightUnitKilograms,
+			state:     132.5,
+			stateUnit: weightUnitPounds,
+			precision: 0.5,
+			expected:  false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plan := NewWeightValue(testCase.plan, defaultWeightPrecision)
+			state := NewWeightValue(testCase.state, defaultWeightPrecision)
+			if got := weightChanged(plan, testCase.planUnit, state, testCase.stateUnit, testCase.precision); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
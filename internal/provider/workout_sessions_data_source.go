@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workoutSessionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &workoutSessionsDataSource{}
+)
+
+// defaultWorkoutSessionsPageSize is the page_size when it is not set.
+const defaultWorkoutSessionsPageSize = 50
+
+func NewWorkoutSessionsDataSource() datasource.DataSource {
+	return &workoutSessionsDataSource{}
+}
+
+type workoutSessionsDataSource struct {
+	client *BrickByBrickClient
+}
+
+type workoutSessionsDataSourceModel struct {
+	From        types.String           `tfsdk:"from"`
+	To          types.String           `tfsdk:"to"`
+	ExerciseIDs []types.Int64          `tfsdk:"exercise_ids"`
+	Page        types.Int32            `tfsdk:"page"`
+	PageSize    types.Int32            `tfsdk:"page_size"`
+	WeightUnit  types.String           `tfsdk:"weight_unit"`
+	TotalCount  types.Int32            `tfsdk:"total_count"`
+	NextPage    types.Int32            `tfsdk:"next_page"`
+	Sessions    []workoutSessionsModel `tfsdk:"sessions"`
+}
+
+type workoutSessionsModel struct {
+	ID                types.Int64                   `tfsdk:"id"`
+	Date              types.String                  `tfsdk:"date"`
+	StrategyID        types.Int64                   `tfsdk:"strategy_id"`
+	WorkoutTemplateID types.Int64                   `tfsdk:"workout_template_id"`
+	Exercises         []workoutSessionExerciseModel `tfsdk:"exercises"`
+	ExerciseGroups    []workoutSessionGroupModel    `tfsdk:"exercise_groups"`
+}
+
+type workoutSessionExerciseModel struct {
+	ExerciseID types.Int64       `tfsdk:"exercise_id"`
+	Sets       []workoutSetModel `tfsdk:"sets"`
+}
+
+type workoutSetModel struct {
+	Weight    types.Float32 `tfsdk:"weight"`
+	Reps      types.Int32   `tfsdk:"reps"`
+	Completed types.Bool    `tfsdk:"completed"`
+}
+
+type workoutSessionGroupModel struct {
+	ExerciseGroupID types.Int64   `tfsdk:"exercise_group_id"`
+	Name            types.String  `tfsdk:"name"`
+	Type            types.String  `tfsdk:"type"`
+	Rounds          types.Int32   `tfsdk:"rounds"`
+	ExerciseIDs     []types.Int64 `tfsdk:"exercise_ids"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workoutSessionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *workoutSessionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workout_sessions"
+}
+
+// Schema defines the schema for the data source.
+func (d *workoutSessionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists logged workout sessions, oldest first, one page at a time.",
+		Attributes: map[string]schema.Attribute{
+			"from": schema.StringAttribute{
+				Description: "Only list sessions on or after this date, in YYYY-MM-DD format.",
+				Optional:    true,
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"to": schema.StringAttribute{
+				Description: "Only list sessions on or before this date, in YYYY-MM-DD format.",
+				Optional:    true,
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"exercise_ids": schema.SetAttribute{
+				Description: "Only list sessions that include at least one of these exercises, and only these exercises within each session.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"page": schema.Int32Attribute{
+				Description: "The page of sessions to return, starting at 1. Defaults to 1.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"page_size": schema.Int32Attribute{
+				Description: fmt.Sprintf("The number of sessions in each page. Defaults to %d.", defaultWorkoutSessionsPageSize),
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 500),
+				},
+			},
+			"weight_unit": schema.StringAttribute{
+				Description: "The unit, lb or kg, that weights are returned in. Defaults to the provider weight_unit.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightUnits...),
+				},
+			},
+			"total_count": schema.Int32Attribute{
+				Description: "The number of sessions that match the filters, across all pages, as counted by the API.",
+				Computed:    true,
+			},
+			"next_page": schema.Int32Attribute{
+				Description: "The page that follows this one, or null when this is the last page.",
+				Computed:    true,
+			},
+			"sessions": schema.ListNestedAttribute{
+				Description: "The sessions in this page, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The unique identifier of the session.",
+							Computed:    true,
+						},
+						"date": schema.StringAttribute{
+							Description: "The date the session was performed, in YYYY-MM-DD format.",
+							Computed:    true,
+						},
+						"strategy_id": schema.Int64Attribute{
+							Description: "The strategy_id of the strategy the session followed.",
+							Computed:    true,
+						},
+						"workout_template_id": schema.Int64Attribute{
+							Description: "The workout_template_id of the workout template the session was performed from, or null.",
+							Computed:    true,
+						},
+						"exercises": schema.ListNestedAttribute{
+							Description: "The exercises performed, in order.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"exercise_id": schema.Int64Attribute{
+										Description: "The exercise_id of the exercise.",
+										Computed:    true,
+									},
+									"sets": schema.ListNestedAttribute{
+										Description: "The sets of the exercise, in order.",
+										Computed:    true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"weight": schema.Float32Attribute{
+													Description: "The weight of the set, in weight_unit.",
+													Computed:    true,
+												},
+												"reps": schema.Int32Attribute{
+													Description: "The number of reps performed.",
+													Computed:    true,
+												},
+												"completed": schema.BoolAttribute{
+													Description: "Whether every prescribed rep of the set was completed.",
+													Computed:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"exercise_groups": schema.ListNestedAttribute{
+							Description: "The supersets, giant sets and circuits of the session's workout template, as they were when the session was logged.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: workoutSessionGroupAttributes(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// workoutSessionGroupAttributes returns the attributes of an exercise group
+// nested in a workout-oriented data source.
+func workoutSessionGroupAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"exercise_group_id": schema.Int64Attribute{
+			Description: "The exercise_group_id of the exercise group.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the exercise group.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The kind of group: superset, giant_set or circuit.",
+			Computed:    true,
+		},
+		"rounds": schema.Int32Attribute{
+			Description: "How many times the whole group is performed.",
+			Computed:    true,
+		},
+		"exercise_ids": schema.ListAttribute{
+			Description: "The exercise_id of each exercise in the group, in the order they are performed.",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workoutSessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workoutSessionsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	weightUnit := resolveWeightUnit(state.WeightUnit, d.client)
+	weightPrecision := resolveWeightPrecision(types.Float64Null(), d.client)
+
+	// Dates in YYYY-MM-DD format sort in date order.
+	if !state.From.IsNull() && !state.To.IsNull() && state.From.ValueString() > state.To.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("to"),
+			"Invalid Date Range",
+			"to must be on or after from.",
+		)
+		return
+	}
+
+	if state.Page.IsNull() {
+		state.Page = types.Int32Value(1)
+	}
+	if state.PageSize.IsNull() {
+		state.PageSize = types.Int32Value(defaultWorkoutSessionsPageSize)
+	}
+
+	options := WorkoutSessionListOptions{
+		From:   state.From.ValueString(),
+		To:     state.To.ValueString(),
+		Limit:  state.PageSize.ValueInt32(),
+		Offset: (state.Page.ValueInt32() - 1) * state.PageSize.ValueInt32(),
+	}
+	for _, exerciseID := range state.ExerciseIDs {
+		options.ExerciseIDs = append(options.ExerciseIDs, int(exerciseID.ValueInt64()))
+	}
+
+	page, err := d.client.ListWorkoutSessions(options)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read BrickByBrick Workout Sessions",
+			err.Error(),
+		)
+		return
+	}
+
+	state.TotalCount = types.Int32Value(page.TotalCount)
+	state.NextPage = types.Int32Null()
+	if options.Offset+int32(len(page.Sessions)) < page.TotalCount {
+		state.NextPage = types.Int32Value(state.Page.ValueInt32() + 1)
+	}
+
+	// Map response body to model
+	for _, session := range filterSessionExercises(page.Sessions, options.ExerciseIDs) {
+		state.Sessions = append(state.Sessions, workoutSessionValue(session, weightUnit, weightPrecision))
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// filterSessionExercises removes the exercises that are not in exerciseIDs
+// from each session. The API filters the sessions, and its total_count
+// counts them, but it returns every exercise of the sessions it matches.
+// Sessions are kept even if no exercise is left, so a page holds the
+// sessions that total_count and next_page describe.
+func filterSessionExercises(sessions []WorkoutSession, exerciseIDs []int) []WorkoutSession {
+	if len(exerciseIDs) == 0 {
+		return sessions
+	}
+
+	filtered := []WorkoutSession{}
+	for _, session := range sessions {
+		exercises := []WorkoutSessionExercise{}
+		for _, exercise := range session.Exercises {
+			if slices.Contains(exerciseIDs, exercise.ExerciseID) {
+				exercises = append(exercises, exercise)
+			}
+		}
+		session.Exercises = exercises
+		filtered = append(filtered, session)
+	}
+	return filtered
+}
+
+// workoutSessionValue maps a session returned by the API onto the data source
+// model, converting weights into weightUnit.
+func workoutSessionValue(session WorkoutSession, weightUnit string, weightPrecision float64) workoutSessionsModel {
+	sessionState := workoutSessionsModel{
+		ID:                types.Int64Value(int64(session.ID)),
+		Date:              types.StringValue(session.Date),
+		StrategyID:        types.Int64Value(int64(session.StrategyID)),
+		WorkoutTemplateID: types.Int64PointerValue(int64PointerValue(session.WorkoutTemplateID)),
+		Exercises:         []workoutSessionExerciseModel{},
+		ExerciseGroups:    workoutSessionGroupValues(session.ExerciseGroups),
+	}
+
+	for _, exercise := range session.Exercises {
+		exerciseState := workoutSessionExerciseModel{
+			ExerciseID: types.Int64Value(int64(exercise.ExerciseID)),
+			Sets:       []workoutSetModel{},
+		}
+		for _, set := range exercise.Sets {
+			exerciseState.Sets = append(exerciseState.Sets, workoutSetModel{
+				Weight:    weightPointerValue(&set.Weight, weightUnit, weightPrecision),
+				Reps:      types.Int32Value(set.Reps),
+				Completed: types.BoolValue(set.Completed),
+			})
+		}
+		sessionState.Exercises = append(sessionState.Exercises, exerciseState)
+	}
+
+	return sessionState
+}
+
+// workoutSessionGroupValues maps exercise groups returned by the API onto the
+// nested model shared by workout-oriented data sources.
+func workoutSessionGroupValues(groups []WorkoutSessionGroup) []workoutSessionGroupModel {
+	values := []workoutSessionGroupModel{}
+	for _, group := range groups {
+		groupState := workoutSessionGroupModel{
+			ExerciseGroupID: types.Int64Value(int64(group.ExerciseGroupID)),
+			Name:            types.StringValue(group.Name),
+			Type:            types.StringValue(group.Type),
+			Rounds:          types.Int32Value(group.Rounds),
+			ExerciseIDs:     []types.Int64{},
+		}
+		for _, exerciseID := range group.ExerciseIDs {
+			groupState.ExerciseIDs = append(groupState.ExerciseIDs, types.Int64Value(int64(exerciseID)))
+		}
+		values = append(values, groupState)
+	}
+	return values
+}
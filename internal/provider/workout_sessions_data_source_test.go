@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFilterSessionExercises(t *testing.T) {
+	sessions := []WorkoutSession{
+		{ID: 1, Exercises: []WorkoutSessionExercise{{ExerciseID: 10}, {ExerciseID: 20}}},
+		{ID: 2, Exercises: []WorkoutSessionExercise{{ExerciseID: 30}}},
+		{ID: 3, Exercises: []WorkoutSessionExercise{{ExerciseID: 20}, {ExerciseID: 30}}},
+	}
+
+	testCases := map[string]struct {
+		exerciseIDs []int
+		want        map[int][]int
+	}{
+		"no-filter": {
+			want: map[int][]int{1: {10, 20}, 2: {30}, 3: {20, 30}},
+		},
+		"single-exercise": {
+			exerciseIDs: []int{20},
+			want:        map[int][]int{1: {20}, 2: nil, 3: {20}},
+		},
+		"several-exercises": {
+			exerciseIDs: []int{10, 30},
+			want:        map[int][]int{1: {10}, 2: {30}, 3: {30}},
+		},
+		"no-matches": {
+			exerciseIDs: []int{40},
+			want:        map[int][]int{1: nil, 2: nil, 3: nil},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := filterSessionExercises(sessions, testCase.exerciseIDs)
+			if len(got) != len(testCase.want) {
+				t.Fatalf("got %d sessions, want %d", len(got), len(testCase.want))
+			}
+			for _, session := range got {
+				var exerciseIDs []int
+				for _, exercise := range session.Exercises {
+					exerciseIDs = append(exerciseIDs, exercise.ExerciseID)
+				}
+				if !slices.Equal(exerciseIDs, testCase.want[session.ID]) {
+					t.Errorf("session %d: got exercises %v, want %v", session.ID, exerciseIDs, testCase.want[session.ID])
+				}
+			}
+		})
+	}
+
+	if len(sessions[0].Exercises) != 2 {
+		t.Errorf("filterSessionExercises modified its input")
+	}
+}
+
+func TestWorkoutSessionsDataSourceReadExerciseIDs(t *testing.T) {
+	var gotQuery string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /workout-sessions", func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		writeJSON(t, w, WorkoutSessionPage{
+			TotalCount: 3,
+			Sessions: []WorkoutSession{
+				{ID: 1, Date: "2025-01-06", Exercises: []WorkoutSessionExercise{{ExerciseID: 10}, {ExerciseID: 20}}},
+				{ID: 2, Date: "2025-01-08", Exercises: []WorkoutSessionExercise{{ExerciseID: 30}}},
+			},
+		})
+	})
+	d := &workoutSessionsDataSource{client: newTestClient(t, mux)}
+
+	var state workoutSessionsDataSourceModel
+	diags := testReadDataSource(t, d, workoutSessionsDataSourceModel{
+		From:        types.StringNull(),
+		To:          types.StringNull(),
+		ExerciseIDs: []types.Int64{types.Int64Value(10)},
+		Page:        types.Int32Null(),
+		PageSize:    types.Int32Value(2),
+		WeightUnit:  types.StringNull(),
+		TotalCount:  types.Int32Unknown(),
+		NextPage:    types.Int32Unknown(),
+	}, &state)
+	if diags.HasError() {
+		t.Fatalf("unexpected error reading: %v", diags)
+	}
+
+	if gotQuery != "exercise_ids=10&limit=2&offset=0" {
+		t.Errorf("expected query %q, got %q", "exercise_ids=10&limit=2&offset=0", gotQuery)
+	}
+	if state.TotalCount.ValueInt32() != 3 {
+		t.Errorf("expected total_count 3, got %s", state.TotalCount)
+	}
+	if state.NextPage.ValueInt32() != 2 {
+		t.Errorf("expected next_page 2, got %s", state.NextPage)
+	}
+	if len(state.Sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(state.Sessions))
+	}
+	if len(state.Sessions[0].Exercises) != 1 || len(state.Sessions[1].Exercises) != 0 {
+		t.Errorf("expected the exercises to be filtered to exercise 10, got %v", state.Sessions)
+	}
+}
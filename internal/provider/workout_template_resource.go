@@ -0,0 +1,471 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workoutTemplateResource{}
+	_ resource.ResourceWithConfigure   = &workoutTemplateResource{}
+	_ resource.ResourceWithImportState = &workoutTemplateResource{}
+	_ resource.ResourceWithModifyPlan  = &workoutTemplateResource{}
+)
+
+// workoutTemplateExerciseAttrTypes are the attribute types of an entry in
+// exercises.
+var workoutTemplateExerciseAttrTypes = map[string]attr.Type{
+	"exercise_id":  types.Int64Type,
+	"sets":         types.Int32Type,
+	"reps":         types.Int32Type,
+	"rest_seconds": types.Int32Type,
+}
+
+// NewWorkoutTemplateResource is a helper function to simplify the provider implementation.
+func NewWorkoutTemplateResource() resource.Resource {
+	return &workoutTemplateResource{}
+}
+
+// workoutTemplateResource is the resource implementation.
+type workoutTemplateResource struct {
+	client *BrickByBrickClient
+}
+
+type workoutTemplateResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	WorkoutTemplateID types.Int64  `tfsdk:"workout_template_id"`
+	Name              types.String `tfsdk:"name"`
+	StrategyID        types.Int64  `tfsdk:"strategy_id"`
+	Exercises         types.List   `tfsdk:"exercises"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+	OwnerID           types.String `tfsdk:"owner_id"`
+}
+
+type workoutTemplateExerciseModel struct {
+	ExerciseID  types.Int64 `tfsdk:"exercise_id"`
+	Sets        types.Int32 `tfsdk:"sets"`
+	Reps        types.Int32 `tfsdk:"reps"`
+	RestSeconds types.Int32 `tfsdk:"rest_seconds"`
+}
+
+// Metadata returns the resource type name.
+func (r *workoutTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workout_template"
+}
+
+// Create a new resource.
+func (r *workoutTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan workoutTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	newWorkoutTemplate := WorkoutTemplate{
+		Name:       plan.Name.ValueString(),
+		StrategyID: int(plan.StrategyID.ValueInt64()),
+	}
+	newWorkoutTemplate.Exercises, diags = workoutTemplateExercisesFromList(ctx, plan.Exercises)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdWorkoutTemplate, err := r.client.CreateWorkoutTemplate(newWorkoutTemplate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating workout template",
+			"Could not create workout template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(plan.refresh(ctx, createdWorkoutTemplate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *workoutTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state workoutTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedWorkoutTemplate, err := r.client.GetWorkoutTemplate(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading BrickByBrick Workout Template",
+			"Could not read BrickByBrick workout template ID "+state.ID.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(state.refresh(ctx, refreshedWorkoutTemplate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workoutTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan workoutTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state workoutTemplateResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from the attributes that changed
+	changes, diags := workoutTemplatePatch(ctx, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var workoutTemplate *WorkoutTemplate
+	if len(changes) > 0 {
+		var err error
+		workoutTemplate, err = r.client.PatchWorkoutTemplate(plan.ID.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating workout template",
+				"Could not update workout template, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Only fetch the workout template when nothing was sent or the PATCH
+	// response did not include it.
+	if workoutTemplate == nil {
+		var err error
+		workoutTemplate, err = r.client.GetWorkoutTemplate(plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading workout template",
+				"Could not read workout template ID "+plan.ID.String()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(plan.refresh(ctx, workoutTemplate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// workoutTemplatePatch returns the API attributes that differ between plan
+// and state. The exercises are always sent as a whole list, since their order
+// matters.
+func workoutTemplatePatch(ctx context.Context, plan, state workoutTemplateResourceModel) (map[string]any, diag.Diagnostics) {
+	changes := map[string]any{}
+
+	if !plan.Name.Equal(state.Name) {
+		changes["name"] = plan.Name.ValueString()
+	}
+	if !plan.StrategyID.Equal(state.StrategyID) {
+		changes["strategy_id"] = plan.StrategyID.ValueInt64()
+	}
+	if !plan.Exercises.Equal(state.Exercises) {
+		exercises, diags := workoutTemplateExercisesFromList(ctx, plan.Exercises)
+		if diags.HasError() {
+			return nil, diags
+		}
+		changes["exercises"] = exercises
+	}
+
+	return changes, nil
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *workoutTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state workoutTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteWorkoutTemplate(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting BrickByBrick Workout Template",
+			"Could not delete workout template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workoutTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*BrickByBrickClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *BrickByBrickClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *workoutTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A workout made up of an ordered list of exercises, performed according to a strategy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the workout template",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workout_template_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The unique identifier for the workout template as a number.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the workout template.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"strategy_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The strategy_id of the strategy the workout follows. The number of exercises must match its exercises_per_workout.",
+			},
+			"exercises": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The exercises in the workout, in the order they are performed.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"exercise_id": schema.Int64Attribute{
+							Required:    true,
+							Description: "The exercise_id of the exercise.",
+						},
+						"sets": schema.Int32Attribute{
+							Optional:    true,
+							Description: "The number of sets for this exercise, overriding the strategy's target_sets_per_exercise.",
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"reps": schema.Int32Attribute{
+							Optional:    true,
+							Description: "The number of reps per set for this exercise, overriding the strategy's target_reps_per_set.",
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"rest_seconds": schema.Int32Attribute{
+							Optional:    true,
+							Description: "The rest between sets of this exercise, in seconds.",
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the workout template was created, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the workout template was last updated, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The identifier of the account that owns the workout template.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *workoutTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan checks that the number of exercises matches the strategy's
+// exercises_per_workout.
+func (r *workoutTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the workout template is being destroyed, or
+	// before the provider has been configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan workoutTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A strategy created in the same apply cannot be looked up yet.
+	if plan.StrategyID.IsUnknown() || plan.Exercises.IsUnknown() {
+		return
+	}
+
+	// Only check when the relevant attributes change, so that the strategy
+	// is not fetched on every plan.
+	if !req.State.Raw.IsNull() {
+		var state workoutTemplateResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.StrategyID.Equal(state.StrategyID) && len(plan.Exercises.Elements()) == len(state.Exercises.Elements()) {
+			return
+		}
+	}
+
+	strategy, err := r.client.GetStrategy(strconv.FormatInt(plan.StrategyID.ValueInt64(), 10))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("strategy_id"),
+			"Unable to Read BrickByBrick Strategy",
+			"Could not read strategy ID "+plan.StrategyID.String()+" to check the number of exercises: "+err.Error(),
+		)
+		return
+	}
+
+	if count := len(plan.Exercises.Elements()); int32(count) != strategy.ExercisesPerWorkout {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("exercises"),
+			"Wrong Number of Exercises",
+			fmt.Sprintf("The workout template has %d exercises, but strategy %q (ID %d) has exercises_per_workout = %d. "+
+				"Add or remove exercises, or use a different strategy.",
+				count, strategy.DisplayName, strategy.ID, strategy.ExercisesPerWorkout),
+		)
+	}
+}
+
+// refresh maps a workout template returned by the API onto the model.
+func (m *workoutTemplateResourceModel) refresh(ctx context.Context, workoutTemplate *WorkoutTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(strconv.Itoa(workoutTemplate.ID))
+	m.WorkoutTemplateID = types.Int64Value(int64(workoutTemplate.ID))
+	m.Name = types.StringValue(workoutTemplate.Name)
+	m.StrategyID = types.Int64Value(int64(workoutTemplate.StrategyID))
+	m.CreatedAt = timestampValueOrNull(workoutTemplate.CreatedAt)
+	m.UpdatedAt = timestampValueOrNull(workoutTemplate.UpdatedAt)
+	m.OwnerID = stringValueOrNull(workoutTemplate.OwnerID)
+
+	exercises := make([]workoutTemplateExerciseModel, 0, len(workoutTemplate.Exercises))
+	for _, exercise := range workoutTemplate.Exercises {
+		exercises = append(exercises, workoutTemplateExerciseModel{
+			ExerciseID:  types.Int64Value(int64(exercise.ExerciseID)),
+			Sets:        types.Int32PointerValue(exercise.Sets),
+			Reps:        types.Int32PointerValue(exercise.Reps),
+			RestSeconds: types.Int32PointerValue(exercise.RestSeconds),
+		})
+	}
+	m.Exercises, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workoutTemplateExerciseAttrTypes}, exercises)
+	return diags
+}
+
+// workoutTemplateExercisesFromList converts the exercises attribute into the
+// entries sent to the API.
+func workoutTemplateExercisesFromList(ctx context.Context, list types.List) ([]WorkoutTemplateExercise, diag.Diagnostics) {
+	var models []workoutTemplateExerciseModel
+	diags := list.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	exercises := make([]WorkoutTemplateExercise, 0, len(models))
+	for _, model := range models {
+		exercises = append(exercises, WorkoutTemplateExercise{
+			ExerciseID:  int(model.ExerciseID.ValueInt64()),
+			Sets:        model.Sets.ValueInt32Pointer(),
+			Reps:        model.Reps.ValueInt32Pointer(),
+			RestSeconds: model.RestSeconds.ValueInt32Pointer(),
+		})
+	}
+	return exercises, diags
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWorkoutTemplatePatch(t *testing.T) {
+	ctx := context.Background()
+	exercisesList := func(exercises ...workoutTemplateExerciseModel) types.List {
+		list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workoutTemplateExerciseAttrTypes}, exercises)
+		if diags.HasError() {
+			t.Fatalf("unexpected error building exercises: %v", diags)
+		}
+		return list
+	}
+	squat := workoutTemplateExerciseModel{
+		ExerciseID:  types.Int64Value(1),
+		Sets:        types.Int32Value(5),
+		Reps:        types.Int32Null(),
+		RestSeconds: types.Int32Value(180),
+	}
+	bench := workoutTemplateExerciseModel{
+		ExerciseID:  types.Int64Value(2),
+		Sets:        types.Int32Null(),
+		Reps:        types.Int32Null(),
+		RestSeconds: types.Int32Null(),
+	}
+
+	state := workoutTemplateResourceModel{
+		Name:       types.StringValue("Day A"),
+		StrategyID: types.Int64Value(7),
+		Exercises:  exercisesList(squat, bench),
+	}
+	sets := int32(5)
+	rest := int32(180)
+
+	testCases := map[string]struct {
+		modify func(plan *workoutTemplateResourceModel)
+		want   map[string]any
+	}{
+		"unchanged": {
+			modify: func(plan *workoutTemplateResourceModel) {},
+			want:   map[string]any{},
+		},
+		"strategy": {
+			modify: func(plan *workoutTemplateResourceModel) { plan.StrategyID = types.Int64Value(8) },
+			want:   map[string]any{"strategy_id": int64(8)},
+		},
+		"reordered-exercises": {
+			modify: func(plan *workoutTemplateResourceModel) { plan.Exercises = exercisesList(bench, squat) },
+			want: map[string]any{"exercises": []WorkoutTemplateExercise{
+				{ExerciseID: 2},
+				{ExerciseID: 1, Sets: &sets, RestSeconds: &rest},
+			}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plan := state
+			testCase.modify(&plan)
+
+			got, diags := workoutTemplatePatch(ctx, plan, state)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("expected changes %v, got %v", testCase.want, got)
+			}
+		})
+	}
+}